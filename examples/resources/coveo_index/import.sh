@@ -0,0 +1 @@
+terraform import coveo_index.example <index_id>
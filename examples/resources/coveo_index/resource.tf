@@ -0,0 +1,8 @@
+resource "coveo_index" "example" {
+  machine_spec = "T3_MEDIUM"
+  storage_gib  = 100
+  replicas     = 2
+
+  # Set to false and apply before destroying the index.
+  deletion_protection = true
+}
@@ -0,0 +1,73 @@
+package coveo
+
+import (
+	"context"
+	"net/url"
+)
+
+// Privilege is a privilege granted to an API key or a group.
+type Privilege struct {
+	Owner        string `json:"owner"`
+	TargetDomain string `json:"targetDomain"`
+	TargetID     string `json:"targetId,omitempty"`
+	Type         string `json:"type,omitempty"`
+}
+
+// APIKey is an API key of the organization. Value is only returned when the
+// key is created.
+type APIKey struct {
+	ID          string      `json:"id,omitempty"`
+	DisplayName string      `json:"displayName"`
+	Description string      `json:"description"`
+	Enabled     bool        `json:"enabled"`
+	Privileges  []Privilege `json:"privileges"`
+	AllowedIPs  []string    `json:"allowedIps,omitempty"`
+	CreatedDate int64       `json:"createdDate,omitempty"`
+	Value       string      `json:"value,omitempty"`
+}
+
+// APIKeysService manages the API keys of the organization.
+type APIKeysService struct {
+	client *Client
+}
+
+func apiKeyEndpoint(id string) string {
+	return "apikeys/" + url.PathEscape(id)
+}
+
+// List returns every API key of the organization.
+func (s *APIKeysService) List(ctx context.Context) ([]APIKey, error) {
+	var keys []APIKey
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL("apikeys", nil), nil, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Get returns the API key with the given ID. Its value is not returned.
+func (s *APIKeysService) Get(ctx context.Context, id string) (*APIKey, error) {
+	var key APIKey
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL(apiKeyEndpoint(id), nil), nil, &key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// Create creates an API key and returns it with its value.
+func (s *APIKeysService) Create(ctx context.Context, key APIKey) (*APIKey, error) {
+	var created APIKey
+	if err := s.client.do(ctx, "POST", s.client.PlatformEndpointURL("apikeys", nil), key, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// Update replaces the API key key.ID.
+func (s *APIKeysService) Update(ctx context.Context, key APIKey) error {
+	return s.client.do(ctx, "PUT", s.client.PlatformEndpointURL(apiKeyEndpoint(key.ID), nil), key, nil)
+}
+
+// Delete removes the API key with the given ID.
+func (s *APIKeysService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, "DELETE", s.client.PlatformEndpointURL(apiKeyEndpoint(id), nil), nil, nil)
+}
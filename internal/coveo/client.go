@@ -0,0 +1,162 @@
+// Package coveo is a typed client for the Coveo Cloud APIs used by the
+// Terraform provider.
+package coveo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// Default base URLs of the Coveo API families. The Push and Logs APIs live on
+// the API host, everything else on the Platform host.
+const (
+	DefaultAPIURL      = "https://api.cloud.coveo.com"
+	DefaultPlatformURL = "https://platform.cloud.coveo.com"
+)
+
+// Client calls the Coveo APIs on behalf of one organization. Its services
+// share the client configuration, so changing the base URLs after NewClient
+// affects every service.
+type Client struct {
+	APIKey         string
+	OrganizationID string
+	UserAgent      string
+	APIURL         string
+	PlatformURL    string
+	HTTPClient     *http.Client
+
+	Sources         *SourcesService
+	Fields          *FieldsService
+	Push            *PushService
+	Pipelines       *PipelinesService
+	Indexes         *IndexesService
+	APIKeys         *APIKeysService
+	CrawlingModules *CrawlingModulesService
+}
+
+// NewClient returns a client for organizationID authenticated with apiKey.
+// A nil httpClient uses http.DefaultClient.
+func NewClient(apiKey, organizationID string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	c := &Client{
+		APIKey:         apiKey,
+		OrganizationID: organizationID,
+		APIURL:         DefaultAPIURL,
+		PlatformURL:    DefaultPlatformURL,
+		HTTPClient:     httpClient,
+	}
+	c.Sources = &SourcesService{client: c}
+	c.Fields = &FieldsService{client: c}
+	c.Push = &PushService{client: c}
+	c.Pipelines = &PipelinesService{client: c}
+	c.Indexes = &IndexesService{client: c}
+	c.APIKeys = &APIKeysService{client: c}
+	c.CrawlingModules = &CrawlingModulesService{client: c}
+	return c
+}
+
+// APIError is returned when the Coveo API answers with an error status.
+type APIError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request error: %s", e.Status)
+}
+
+// IsNotFound reports whether err is a 404 returned by the Coveo API.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// PlatformEndpointURL returns the URL of endpoint on the organization in the
+// Platform API. An empty endpoint targets the organization itself.
+func (c *Client) PlatformEndpointURL(endpoint string, query url.Values) string {
+	u := fmt.Sprintf("%s/rest/organizations/%s", c.PlatformURL, url.PathEscape(c.OrganizationID))
+	if endpoint != "" {
+		u = fmt.Sprintf("%s/%s", u, endpoint)
+	}
+	return withQuery(u, query)
+}
+
+// PushEndpointURL returns the URL of endpoint on the organization in the Push API.
+func (c *Client) PushEndpointURL(endpoint string, query url.Values) string {
+	u := fmt.Sprintf("%s/push/v1/organizations/%s/%s", c.APIURL, url.PathEscape(c.OrganizationID), endpoint)
+	return withQuery(u, query)
+}
+
+// SearchAdminEndpointURL returns the URL of endpoint in the Search API
+// administration endpoints, which take the organization as a query parameter.
+func (c *Client) SearchAdminEndpointURL(endpoint string, query url.Values) string {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("organizationId", c.OrganizationID)
+	return withQuery(fmt.Sprintf("%s/rest/search/v1/admin/%s", c.PlatformURL, endpoint), q)
+}
+
+func withQuery(u string, query url.Values) string {
+	if len(query) == 0 {
+		return u
+	}
+	return u + "?" + query.Encode()
+}
+
+// DoRaw sends an authenticated request to an absolute URL with body encoded
+// as JSON, and returns the raw response body.
+func (c *Client) DoRaw(ctx context.Context, method, u string, body interface{}) ([]byte, error) {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		if reqBody, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, &APIError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// do sends a request and decodes the JSON response into out, unless out is
+// nil or the response is empty.
+func (c *Client) do(ctx context.Context, method, u string, body, out interface{}) error {
+	data, err := c.DoRaw(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decoding %s %s response: %w", method, u, err)
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package coveo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// newTestClient returns a Client whose API families all point at a test
+// server running handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient("xx-test-key", "myorg", server.Client())
+	client.UserAgent = "coveo-test"
+	client.APIURL = server.URL
+	client.PlatformURL = server.URL
+	return client
+}
+
+func TestClient_Headers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer xx-test-key" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "coveo-test" {
+			t.Errorf("User-Agent = %q", got)
+		}
+		fmt.Fprint(w, `[]`)
+	})
+
+	if _, err := client.Indexes.List(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_NotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+
+	_, err := client.Sources.Get(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false, want true", err)
+	}
+}
+
+func TestClient_EndpointURLs(t *testing.T) {
+	client := NewClient("xx-test-key", "my org", nil)
+	client.APIURL = "https://api.example.com"
+	client.PlatformURL = "https://platform.example.com"
+
+	query := url.Values{"page": {"1"}}
+	for got, want := range map[string]string{
+		client.PlatformEndpointURL("", nil):                 "https://platform.example.com/rest/organizations/my%20org",
+		client.PlatformEndpointURL("sources", query):        "https://platform.example.com/rest/organizations/my%20org/sources?page=1",
+		client.PushEndpointURL("sources/s1/documents", nil): "https://api.example.com/push/v1/organizations/my%20org/sources/s1/documents",
+		client.SearchAdminEndpointURL("pipelines", query):   "https://platform.example.com/rest/search/v1/admin/pipelines?organizationId=my+org&page=1",
+	} {
+		if got != want {
+			t.Errorf("got %s, want %s", got, want)
+		}
+	}
+	if _, ok := query["organizationId"]; ok {
+		t.Error("SearchAdminEndpointURL modified the query")
+	}
+}
+
+func TestSources_FindByName(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/sources/page/detailed" || r.URL.Query().Get("filter") != "docs" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		fmt.Fprint(w, `{"sourceModels":[{"id":"s1","name":"docs-old"},{"id":"s2","name":"docs","information":{"numberOfDocuments":12}}],"totalEntries":2}`)
+	})
+
+	source, err := client.Sources.FindByName(context.Background(), "docs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source.ID != "s2" || source.Information.NumberOfDocuments != 12 {
+		t.Errorf("unexpected source %+v", source)
+	}
+}
+
+func TestFields_List(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/indexes/page/fields" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("type") != "STRING" || q.Get("perPage") != fmt.Sprint(defaultPageSize) {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		fmt.Fprintf(w, `{"items":[{"name":"f%s"}],"totalPages":3}`, q.Get("page"))
+	})
+
+	fields, err := client.Fields.List(context.Background(), FieldListOptions{Type: "STRING"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fields) != 3 || fields[2].Name != "f2" {
+		t.Errorf("unexpected fields %+v", fields)
+	}
+}
+
+func TestPipelines_Get(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/search/v1/admin/pipelines/p1" || r.URL.Query().Get("organizationId") != "myorg" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		fmt.Fprint(w, `{"id":"p1","name":"default","condition":{"id":"c1","definition":"when $searchHub is \"docs\""}}`)
+	})
+
+	pipeline, err := client.Pipelines.Get(context.Background(), "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pipeline.Condition == nil || pipeline.Condition.ID != "c1" {
+		t.Errorf("unexpected pipeline %+v", pipeline)
+	}
+}
+
+func TestPush_PutDocument(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/push/v1/organizations/myorg/sources/src/documents" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("documentId"); got != "https://example.com/a?b=c" {
+			t.Errorf("documentId = %q", got)
+		}
+		var doc Document
+		if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+			t.Fatal(err)
+		}
+		if doc.Title != "A" || doc.Content != "body" {
+			t.Errorf("unexpected document %+v", doc)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := client.Push.PutDocument(context.Background(), "src", "https://example.com/a?b=c", Document{Title: "A", Content: "body"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAPIKeys_Create(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/organizations/myorg/apikeys" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var key APIKey
+		if err := json.NewDecoder(r.Body).Decode(&key); err != nil {
+			t.Fatal(err)
+		}
+		if len(key.Privileges) != 1 || key.Privileges[0].TargetDomain != "IMPERSONATE" {
+			t.Errorf("unexpected key %+v", key)
+		}
+		fmt.Fprint(w, `{"id":"k1","displayName":"search","value":"xx-secret"}`)
+	})
+
+	key, err := client.APIKeys.Create(context.Background(), APIKey{
+		DisplayName: "search",
+		Enabled:     true,
+		Privileges:  []Privilege{{Owner: "SEARCH_API", TargetDomain: "IMPERSONATE"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.ID != "k1" || key.Value != "xx-secret" {
+		t.Errorf("unexpected key %+v", key)
+	}
+}
+
+func TestIndexes_Create(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/organizations/myorg/indexes" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var index Index
+		if err := json.NewDecoder(r.Body).Decode(&index); err != nil {
+			t.Fatal(err)
+		}
+		if index.MachineSpec == nil || index.MachineSpec.Architecture != "T3_MEDIUM" || index.Replicas != 2 {
+			t.Errorf("unexpected index %+v", index)
+		}
+		fmt.Fprint(w, `{"id":"idx"}`)
+	})
+
+	id, err := client.Indexes.Create(context.Background(), Index{
+		MachineSpec: &MachineSpec{Architecture: "T3_MEDIUM"},
+		Replicas:    2,
+		Slices:      1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "idx" {
+		t.Errorf("id = %q, want idx", id)
+	}
+}
+
+func TestSources_CreateSchedule(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/organizations/myorg/sources/src1/schedules" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var schedule Schedule
+		if err := json.NewDecoder(r.Body).Decode(&schedule); err != nil {
+			t.Fatal(err)
+		}
+		if schedule.RefreshType != ScheduleRefreshRescan || schedule.Recurrence.CronExpression != "0 2 * * SUN" || schedule.Recurrence.TimeZone != "America/Montreal" {
+			t.Errorf("unexpected schedule %+v", schedule)
+		}
+		fmt.Fprint(w, `{"id":"sch1","enabled":true,"refreshType":"RESCAN","recurrence":{"cronExpression":"0 2 * * SUN","timezone":"America/Montreal"}}`)
+	})
+
+	created, err := client.Sources.CreateSchedule(context.Background(), "src1", Schedule{
+		Enabled:     true,
+		RefreshType: ScheduleRefreshRescan,
+		Recurrence:  ScheduleRecurrence{CronExpression: "0 2 * * SUN", TimeZone: "America/Montreal"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID != "sch1" {
+		t.Errorf("unexpected schedule %+v", created)
+	}
+}
+
+func TestSources_UpdateSchedule(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/organizations/myorg/sources/src1/schedules/sch1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	err := client.Sources.UpdateSchedule(context.Background(), "src1", Schedule{ID: "sch1", RefreshType: ScheduleRefreshRebuild})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCrawlingModules_Create(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/organizations/myorg/crawlingmodules" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var module CrawlingModule
+		if err := json.NewDecoder(r.Body).Decode(&module); err != nil {
+			t.Fatal(err)
+		}
+		if module.NumberOfWorkers != 2 || module.MaintenanceWindow == nil || module.MaintenanceWindow.DurationHours != 4 {
+			t.Errorf("unexpected crawling module %+v", module)
+		}
+		fmt.Fprint(w, `{"id":"cm1","name":"onprem","numberOfWorkers":2,"status":"PENDING_REGISTRATION","registrationToken":"xx-token"}`)
+	})
+
+	created, err := client.CrawlingModules.Create(context.Background(), CrawlingModule{
+		Name:              "onprem",
+		NumberOfWorkers:   2,
+		MaintenanceWindow: &MaintenanceWindow{DayOfWeek: "SUNDAY", StartTime: "02:00", DurationHours: 4, TimeZone: "UTC"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID != "cm1" || created.RegistrationToken != "xx-token" {
+		t.Errorf("unexpected crawling module %+v", created)
+	}
+}
+
+func TestCrawlingModules_Get(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/rest/organizations/myorg/crawlingmodules/cm1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id":"cm1","name":"onprem","numberOfWorkers":1,"maintenanceWindow":{"dayOfWeek":"MONDAY","startTime":"03:00","durationInHours":2,"timezone":"UTC"},"status":"ONLINE","version":"2.1"}`)
+	})
+
+	module, err := client.CrawlingModules.Get(context.Background(), "cm1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if module.Status != "ONLINE" || module.MaintenanceWindow == nil || module.MaintenanceWindow.DayOfWeek != "MONDAY" {
+		t.Errorf("unexpected crawling module %+v", module)
+	}
+}
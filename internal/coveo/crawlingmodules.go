@@ -0,0 +1,82 @@
+package coveo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CrawlingModule is a Crawling Module instance, which crawls on-premises
+// content for the sources assigned to it.
+type CrawlingModule struct {
+	ID                string             `json:"id,omitempty"`
+	Name              string             `json:"name"`
+	NumberOfWorkers   int64              `json:"numberOfWorkers"`
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	// Status and Version are reported by the instance once it is registered.
+	Status  string `json:"status,omitempty"`
+	Version string `json:"version,omitempty"`
+	// RegistrationToken is returned on creation. The on-premises instance
+	// uses it to register.
+	RegistrationToken string `json:"registrationToken,omitempty"`
+}
+
+// MaintenanceWindow is when a Crawling Module may update and restart.
+type MaintenanceWindow struct {
+	// DayOfWeek is a day such as SUNDAY.
+	DayOfWeek string `json:"dayOfWeek"`
+	// StartTime is a time of day such as 02:00.
+	StartTime     string `json:"startTime"`
+	DurationHours int64  `json:"durationInHours"`
+	TimeZone      string `json:"timezone"`
+}
+
+// CrawlingModulesService manages the Crawling Modules of the organization.
+type CrawlingModulesService struct {
+	client *Client
+}
+
+func crawlingModuleEndpoint(id string) string {
+	return "crawlingmodules/" + url.PathEscape(id)
+}
+
+// List returns every Crawling Module of the organization.
+func (s *CrawlingModulesService) List(ctx context.Context) ([]CrawlingModule, error) {
+	var modules []CrawlingModule
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL("crawlingmodules", nil), nil, &modules); err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
+
+// Get returns the Crawling Module with the given ID.
+func (s *CrawlingModulesService) Get(ctx context.Context, id string) (*CrawlingModule, error) {
+	var module CrawlingModule
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL(crawlingModuleEndpoint(id), nil), nil, &module); err != nil {
+		return nil, err
+	}
+	return &module, nil
+}
+
+// Create declares a Crawling Module and returns it with its registration
+// token.
+func (s *CrawlingModulesService) Create(ctx context.Context, module CrawlingModule) (*CrawlingModule, error) {
+	var created CrawlingModule
+	if err := s.client.do(ctx, "POST", s.client.PlatformEndpointURL("crawlingmodules", nil), module, &created); err != nil {
+		return nil, err
+	}
+	if created.ID == "" {
+		return nil, fmt.Errorf("crawling module creation response did not include an ID")
+	}
+	return &created, nil
+}
+
+// Update replaces the configuration of the Crawling Module module.ID.
+func (s *CrawlingModulesService) Update(ctx context.Context, module CrawlingModule) error {
+	return s.client.do(ctx, "PUT", s.client.PlatformEndpointURL(crawlingModuleEndpoint(module.ID), nil), module, nil)
+}
+
+// Delete unregisters the Crawling Module with the given ID.
+func (s *CrawlingModulesService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, "DELETE", s.client.PlatformEndpointURL(crawlingModuleEndpoint(id), nil), nil, nil)
+}
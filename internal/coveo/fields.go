@@ -0,0 +1,54 @@
+package coveo
+
+import (
+	"context"
+	"net/url"
+)
+
+// Field is an index field. Fields are identified by their name.
+type Field struct {
+	Name             string `json:"name"`
+	Type             string `json:"type"`
+	Description      string `json:"description"`
+	Facet            bool   `json:"facet"`
+	MultiValueFacet  bool   `json:"multiValueFacet"`
+	Sort             bool   `json:"sort"`
+	IncludeInQuery   bool   `json:"includeInQuery"`
+	IncludeInResults bool   `json:"includeInResults"`
+	System           bool   `json:"system"`
+}
+
+// FieldListOptions filters the fields returned by FieldsService.List. Empty
+// options are not filtered on.
+type FieldListOptions struct {
+	// Filter is matched against field names.
+	Filter string
+	// Type is a field type such as STRING or LONG.
+	Type string
+}
+
+// FieldsService reads the index fields of the organization.
+type FieldsService struct {
+	client *Client
+}
+
+// List returns every field matching opts.
+func (s *FieldsService) List(ctx context.Context, opts FieldListOptions) ([]Field, error) {
+	query := url.Values{}
+	if opts.Filter != "" {
+		query.Set("filter", opts.Filter)
+	}
+	if opts.Type != "" {
+		query.Set("type", opts.Type)
+	}
+	return listPlatform[Field](ctx, s.client, "indexes/page/fields", query, "items")
+}
+
+// Get returns the field with the given name.
+func (s *FieldsService) Get(ctx context.Context, name string) (*Field, error) {
+	var field Field
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL("indexes/fields/"+url.PathEscape(name), nil), nil, &field); err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
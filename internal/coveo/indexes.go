@@ -0,0 +1,166 @@
+package coveo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Index statuses reported by the Platform API.
+const (
+	IndexStatusProvisioning = "PROVISIONING"
+	IndexStatusOnline       = "ONLINE"
+	IndexStatusRestoring    = "RESTORING"
+	IndexStatusFailed       = "FAILED"
+)
+
+// Index is a physical index of the organization.
+type Index struct {
+	ID           string       `json:"id,omitempty"`
+	LogicalIndex string       `json:"logicalIndex,omitempty"`
+	Region       string       `json:"region,omitempty"`
+	Online       bool         `json:"online,omitempty"`
+	Status       string       `json:"status,omitempty"`
+	MachineSpec  *MachineSpec `json:"machineSpec,omitempty"`
+	Replicas     int64        `json:"numberOfReplicas,omitempty"`
+	Slices       int64        `json:"numberOfSlices,omitempty"`
+	// LastRestoreDate is when a backup was last restored into the index, in
+	// milliseconds since the epoch, or 0 if none was.
+	LastRestoreDate int64 `json:"lastRestoreDate,omitempty"`
+}
+
+// MachineSpec is the hardware an index runs on.
+type MachineSpec struct {
+	// Architecture is the machine type, such as T3_MEDIUM.
+	Architecture string      `json:"architecture"`
+	StorageSpec  StorageSpec `json:"storageSpec"`
+}
+
+// StorageSpec is the disk attached to each index machine.
+type StorageSpec struct {
+	NumberOfGibibytes int64  `json:"numberOfGibibytes,omitempty"`
+	StorageType       string `json:"storageType,omitempty"`
+}
+
+// IndexesService manages the physical indexes of the organization.
+type IndexesService struct {
+	client *Client
+}
+
+func indexEndpoint(id string) string {
+	return "indexes/" + url.PathEscape(id)
+}
+
+// List returns every index of the organization.
+func (s *IndexesService) List(ctx context.Context) ([]Index, error) {
+	var indexes []Index
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL("indexes", nil), nil, &indexes); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+// Get returns the index with the given ID.
+func (s *IndexesService) Get(ctx context.Context, id string) (*Index, error) {
+	var index Index
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL(indexEndpoint(id), nil), nil, &index); err != nil {
+		return nil, err
+	}
+	return &index, nil
+}
+
+// FindByLogicalIndex returns the first index serving logicalIndex.
+func (s *IndexesService) FindByLogicalIndex(ctx context.Context, logicalIndex string) (*Index, error) {
+	indexes, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range indexes {
+		if indexes[i].LogicalIndex == logicalIndex {
+			return &indexes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no index serving logical index %q", logicalIndex)
+}
+
+// Create provisions an index and returns its ID. Provisioning continues
+// asynchronously until the index is online.
+func (s *IndexesService) Create(ctx context.Context, index Index) (string, error) {
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := s.client.do(ctx, "POST", s.client.PlatformEndpointURL("indexes", nil), index, &created); err != nil {
+		return "", err
+	}
+	if created.ID == "" {
+		return "", fmt.Errorf("index creation response did not include an ID")
+	}
+	return created.ID, nil
+}
+
+// Update changes the machine specification and replicas of the index
+// index.ID.
+func (s *IndexesService) Update(ctx context.Context, index Index) error {
+	return s.client.do(ctx, "PUT", s.client.PlatformEndpointURL(indexEndpoint(index.ID), nil), index, nil)
+}
+
+// Delete removes the index with the given ID and the documents it holds.
+func (s *IndexesService) Delete(ctx context.Context, id string) error {
+	return s.client.do(ctx, "DELETE", s.client.PlatformEndpointURL(indexEndpoint(id), nil), nil, nil)
+}
+
+// Index backup statuses reported by the Platform API.
+const (
+	IndexBackupStatusInProgress = "IN_PROGRESS"
+	IndexBackupStatusCompleted  = "COMPLETED"
+	IndexBackupStatusFailed     = "FAILED"
+)
+
+// IndexBackup is a snapshot of the documents and configuration of an index.
+type IndexBackup struct {
+	ID          string `json:"id"`
+	IndexID     string `json:"indexId"`
+	Status      string `json:"status"`
+	CreatedDate int64  `json:"createdDate"`
+	SizeInBytes int64  `json:"sizeInBytes"`
+}
+
+func indexBackupEndpoint(id string) string {
+	return "indexes/backups/" + url.PathEscape(id)
+}
+
+// CreateBackup starts a backup of the index indexID and returns the backup
+// ID. The backup completes asynchronously.
+func (s *IndexesService) CreateBackup(ctx context.Context, indexID string) (string, error) {
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := s.client.do(ctx, "POST", s.client.PlatformEndpointURL(indexEndpoint(indexID)+"/backups", nil), nil, &created); err != nil {
+		return "", err
+	}
+	if created.ID == "" {
+		return "", fmt.Errorf("index backup response did not include an ID")
+	}
+	return created.ID, nil
+}
+
+// GetBackup returns the index backup with the given ID.
+func (s *IndexesService) GetBackup(ctx context.Context, id string) (*IndexBackup, error) {
+	var backup IndexBackup
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL(indexBackupEndpoint(id), nil), nil, &backup); err != nil {
+		return nil, err
+	}
+	return &backup, nil
+}
+
+// DeleteBackup removes the index backup with the given ID.
+func (s *IndexesService) DeleteBackup(ctx context.Context, id string) error {
+	return s.client.do(ctx, "DELETE", s.client.PlatformEndpointURL(indexBackupEndpoint(id), nil), nil, nil)
+}
+
+// RestoreBackup replaces the content of the index indexID with the backup
+// backupID. The index is offline until the restore completes.
+func (s *IndexesService) RestoreBackup(ctx context.Context, indexID, backupID string) error {
+	endpoint := fmt.Sprintf("%s/backups/%s/restore", indexEndpoint(indexID), url.PathEscape(backupID))
+	return s.client.do(ctx, "POST", s.client.PlatformEndpointURL(endpoint, nil), nil, nil)
+}
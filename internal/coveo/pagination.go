@@ -0,0 +1,132 @@
+package coveo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// defaultPageSize is the number of items requested per page by listings that
+// don't need a specific size.
+const defaultPageSize = 100
+
+// PageRequest identifies the page ListAll asks for.
+type PageRequest struct {
+	// Page is the zero-based page number.
+	Page int
+	// PerPage is the number of items requested per page.
+	PerPage int
+	// Token is the continuation token returned with the previous page, empty
+	// for the first page and for APIs paginating by page number.
+	Token string
+}
+
+// Page is one page of a paginated listing.
+type Page[T any] struct {
+	Items []T
+	// TotalPages and TotalEntries are the totals reported by the API, zero
+	// when it doesn't report them.
+	TotalPages   int
+	TotalEntries int
+	// NextToken is the continuation token of the next page, for APIs
+	// paginating with tokens.
+	NextToken string
+}
+
+// ListAll fetches every page of a listing and returns all items. It stops on
+// an empty page, when the next continuation token is empty, when the reported
+// page or entry totals are reached, or, when the API reports no totals, on a
+// page shorter than perPage. ctx is checked before each page.
+func ListAll[T any](ctx context.Context, perPage int, fetch func(context.Context, PageRequest) (*Page[T], error)) ([]T, error) {
+	var items []T
+	req := PageRequest{PerPage: perPage}
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		page, err := fetch(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+
+		switch {
+		case len(page.Items) == 0:
+			return items, nil
+		case page.NextToken != "":
+			req.Token = page.NextToken
+		case req.Token != "":
+			return items, nil
+		case page.TotalPages > 0:
+			if req.Page+1 >= page.TotalPages {
+				return items, nil
+			}
+		case page.TotalEntries > 0:
+			if len(items) >= page.TotalEntries {
+				return items, nil
+			}
+		case len(page.Items) < perPage:
+			return items, nil
+		}
+		req.Page++
+	}
+}
+
+// DecodePage decodes a page whose items are under itemsKey, or which is a
+// bare JSON array when itemsKey is empty.
+func DecodePage[T any](body []byte, itemsKey string) (*Page[T], error) {
+	page := &Page[T]{}
+	if itemsKey == "" {
+		if err := json.Unmarshal(body, &page.Items); err != nil {
+			return nil, err
+		}
+		return page, nil
+	}
+
+	var resp map[string]json.RawMessage
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if raw, ok := resp[itemsKey]; ok {
+		if err := json.Unmarshal(raw, &page.Items); err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", itemsKey, err)
+		}
+	}
+	for key, dst := range map[string]interface{}{
+		"totalPages":    &page.TotalPages,
+		"totalEntries":  &page.TotalEntries,
+		"nextPageToken": &page.NextToken,
+	} {
+		if raw, ok := resp[key]; ok {
+			if err := json.Unmarshal(raw, dst); err != nil {
+				return nil, fmt.Errorf("decoding %s: %w", key, err)
+			}
+		}
+	}
+	return page, nil
+}
+
+// PageQuery returns a copy of query with the page and perPage parameters of
+// req set.
+func PageQuery(query url.Values, req PageRequest) url.Values {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("page", fmt.Sprint(req.Page))
+	q.Set("perPage", fmt.Sprint(req.PerPage))
+	return q
+}
+
+// listPlatform lists every item of a paginated Platform API endpoint. Items
+// are read under itemsKey, see DecodePage.
+func listPlatform[T any](ctx context.Context, c *Client, endpoint string, query url.Values, itemsKey string) ([]T, error) {
+	return ListAll(ctx, defaultPageSize, func(ctx context.Context, req PageRequest) (*Page[T], error) {
+		body, err := c.DoRaw(ctx, "GET", c.PlatformEndpointURL(endpoint, PageQuery(query, req)), nil)
+		if err != nil {
+			return nil, err
+		}
+		return DecodePage[T](body, itemsKey)
+	})
+}
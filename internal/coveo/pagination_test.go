@@ -0,0 +1,75 @@
+package coveo
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestListAll(t *testing.T) {
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		pages     []*Page[int]
+		wantItems int
+		wantCalls int
+	}{
+		"total pages": {
+			pages:     []*Page[int]{{Items: []int{1, 2}, TotalPages: 2}, {Items: []int{3}, TotalPages: 2}},
+			wantItems: 3,
+			wantCalls: 2,
+		},
+		"total entries": {
+			pages:     []*Page[int]{{Items: []int{1, 2}, TotalEntries: 3}, {Items: []int{3}, TotalEntries: 3}},
+			wantItems: 3,
+			wantCalls: 2,
+		},
+		"short page": {
+			pages:     []*Page[int]{{Items: []int{1, 2}}, {Items: []int{3}}},
+			wantItems: 3,
+			wantCalls: 2,
+		},
+		"continuation token": {
+			pages:     []*Page[int]{{Items: []int{1}, NextToken: "a"}, {Items: []int{2}, NextToken: "b"}, {Items: []int{3}}},
+			wantItems: 3,
+			wantCalls: 3,
+		},
+		"empty page": {
+			pages:     []*Page[int]{{Items: []int{1, 2}}, {}},
+			wantItems: 2,
+			wantCalls: 2,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			items, err := ListAll(ctx, 2, func(ctx context.Context, req PageRequest) (*Page[int], error) {
+				if req.Page != calls {
+					t.Errorf("requested page %d, want %d", req.Page, calls)
+				}
+				if calls > 0 && c.pages[calls-1].NextToken != req.Token {
+					t.Errorf("requested token %q, want %q", req.Token, c.pages[calls-1].NextToken)
+				}
+				calls++
+				return c.pages[calls-1], nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(items) != c.wantItems || calls != c.wantCalls {
+				t.Errorf("got %d items in %d calls, want %d in %d", len(items), calls, c.wantItems, c.wantCalls)
+			}
+		})
+	}
+}
+
+func TestListAll_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := ListAll(ctx, 1, func(ctx context.Context, req PageRequest) (*Page[int], error) {
+		cancel()
+		return &Page[int]{Items: []int{1}, TotalPages: 10}, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+}
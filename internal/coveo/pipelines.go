@@ -0,0 +1,66 @@
+package coveo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Pipeline is a query pipeline of the Search API.
+type Pipeline struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	IsDefault   bool               `json:"isDefault"`
+	Condition   *PipelineCondition `json:"condition"`
+}
+
+// PipelineCondition is the condition routing queries to a pipeline.
+type PipelineCondition struct {
+	ID         string `json:"id"`
+	Definition string `json:"definition"`
+}
+
+// PipelinesService reads the query pipelines of the organization.
+type PipelinesService struct {
+	client *Client
+}
+
+// List returns every pipeline matching filter, which the API applies to
+// pipeline names. An empty filter returns all pipelines.
+func (s *PipelinesService) List(ctx context.Context, filter string) ([]Pipeline, error) {
+	query := url.Values{}
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	return ListAll(ctx, defaultPageSize, func(ctx context.Context, req PageRequest) (*Page[Pipeline], error) {
+		body, err := s.client.DoRaw(ctx, "GET", s.client.SearchAdminEndpointURL("pipelines", PageQuery(query, req)), nil)
+		if err != nil {
+			return nil, err
+		}
+		return DecodePage[Pipeline](body, "")
+	})
+}
+
+// Get returns the pipeline with the given ID.
+func (s *PipelinesService) Get(ctx context.Context, id string) (*Pipeline, error) {
+	var pipeline Pipeline
+	if err := s.client.do(ctx, "GET", s.client.SearchAdminEndpointURL("pipelines/"+url.PathEscape(id), nil), nil, &pipeline); err != nil {
+		return nil, err
+	}
+	return &pipeline, nil
+}
+
+// FindByName returns the pipeline named exactly name.
+func (s *PipelinesService) FindByName(ctx context.Context, name string) (*Pipeline, error) {
+	pipelines, err := s.List(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pipelines {
+		if pipelines[i].Name == name {
+			return &pipelines[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no query pipeline named %q", name)
+}
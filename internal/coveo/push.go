@@ -0,0 +1,44 @@
+package coveo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Document is a document pushed to a Push source.
+type Document struct {
+	Title   string `json:"title"`
+	Content string `json:"content"`
+}
+
+// PushService adds, reads and removes documents of Push sources.
+type PushService struct {
+	client *Client
+}
+
+func documentsEndpoint(sourceID string) string {
+	return fmt.Sprintf("sources/%s/documents", url.PathEscape(sourceID))
+}
+
+// PutDocument adds or replaces the document documentID of a source.
+func (s *PushService) PutDocument(ctx context.Context, sourceID, documentID string, doc Document) error {
+	query := url.Values{"documentId": {documentID}}
+	return s.client.do(ctx, "PUT", s.client.PushEndpointURL(documentsEndpoint(sourceID), query), doc, nil)
+}
+
+// GetDocument returns the document documentID of a source.
+func (s *PushService) GetDocument(ctx context.Context, sourceID, documentID string) (*Document, error) {
+	var doc Document
+	endpoint := fmt.Sprintf("%s/%s", documentsEndpoint(sourceID), url.PathEscape(documentID))
+	if err := s.client.do(ctx, "GET", s.client.PushEndpointURL(endpoint, nil), nil, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// DeleteDocument removes the document documentID from a source.
+func (s *PushService) DeleteDocument(ctx context.Context, sourceID, documentID string) error {
+	query := url.Values{"documentId": {documentID}}
+	return s.client.do(ctx, "DELETE", s.client.PushEndpointURL(documentsEndpoint(sourceID), query), nil, nil)
+}
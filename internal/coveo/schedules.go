@@ -0,0 +1,80 @@
+package coveo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Schedule refresh types accepted by the Platform API.
+const (
+	ScheduleRefreshIncremental = "INCREMENTAL_REFRESH"
+	ScheduleRefreshRescan      = "RESCAN"
+	ScheduleRefreshRebuild     = "REBUILD"
+)
+
+// Schedule runs an operation on a source at the times matching a cron
+// expression.
+type Schedule struct {
+	ID          string             `json:"id,omitempty"`
+	Enabled     bool               `json:"enabled"`
+	RefreshType string             `json:"refreshType"`
+	Recurrence  ScheduleRecurrence `json:"recurrence"`
+}
+
+// ScheduleRecurrence is when a schedule runs.
+type ScheduleRecurrence struct {
+	// CronExpression has five fields: minute, hour, day of month, month and
+	// day of week.
+	CronExpression string `json:"cronExpression"`
+	// TimeZone is an IANA time zone such as America/Montreal.
+	TimeZone string `json:"timezone"`
+}
+
+func schedulesEndpoint(sourceID string) string {
+	return fmt.Sprintf("sources/%s/schedules", url.PathEscape(sourceID))
+}
+
+func scheduleEndpoint(sourceID, id string) string {
+	return fmt.Sprintf("%s/%s", schedulesEndpoint(sourceID), url.PathEscape(id))
+}
+
+// ListSchedules returns the schedules of the source sourceID.
+func (s *SourcesService) ListSchedules(ctx context.Context, sourceID string) ([]Schedule, error) {
+	var schedules []Schedule
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL(schedulesEndpoint(sourceID), nil), nil, &schedules); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
+
+// GetSchedule returns the schedule id of the source sourceID.
+func (s *SourcesService) GetSchedule(ctx context.Context, sourceID, id string) (*Schedule, error) {
+	var schedule Schedule
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL(scheduleEndpoint(sourceID, id), nil), nil, &schedule); err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// CreateSchedule adds a schedule to the source sourceID and returns it.
+func (s *SourcesService) CreateSchedule(ctx context.Context, sourceID string, schedule Schedule) (*Schedule, error) {
+	var created Schedule
+	if err := s.client.do(ctx, "POST", s.client.PlatformEndpointURL(schedulesEndpoint(sourceID), nil), schedule, &created); err != nil {
+		return nil, err
+	}
+	if created.ID == "" {
+		return nil, fmt.Errorf("schedule creation response did not include an ID")
+	}
+	return &created, nil
+}
+
+// UpdateSchedule replaces the schedule schedule.ID of the source sourceID.
+func (s *SourcesService) UpdateSchedule(ctx context.Context, sourceID string, schedule Schedule) error {
+	return s.client.do(ctx, "PUT", s.client.PlatformEndpointURL(scheduleEndpoint(sourceID, schedule.ID), nil), schedule, nil)
+}
+
+// DeleteSchedule removes the schedule id from the source sourceID.
+func (s *SourcesService) DeleteSchedule(ctx context.Context, sourceID, id string) error {
+	return s.client.do(ctx, "DELETE", s.client.PlatformEndpointURL(scheduleEndpoint(sourceID, id), nil), nil, nil)
+}
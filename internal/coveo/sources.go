@@ -0,0 +1,140 @@
+package coveo
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Source is a content source of the organization.
+type Source struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	SourceType       string            `json:"sourceType"`
+	SourceVisibility string            `json:"sourceVisibility"`
+	PushEnabled      bool              `json:"pushEnabled"`
+	Information      SourceInformation `json:"information"`
+}
+
+// SourceInformation holds the status of a source.
+type SourceInformation struct {
+	NumberOfDocuments int64 `json:"numberOfDocuments"`
+}
+
+// SourcesService reads the sources of the organization.
+type SourcesService struct {
+	client *Client
+}
+
+// List returns every source matching filter, which the API applies to source
+// names. An empty filter returns all sources.
+func (s *SourcesService) List(ctx context.Context, filter string) ([]Source, error) {
+	query := url.Values{}
+	if filter != "" {
+		query.Set("filter", filter)
+	}
+	return listPlatform[Source](ctx, s.client, "sources/page/detailed", query, "sourceModels")
+}
+
+// Get returns the source with the given ID.
+func (s *SourcesService) Get(ctx context.Context, id string) (*Source, error) {
+	var source Source
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL("sources/"+url.PathEscape(id), nil), nil, &source); err != nil {
+		return nil, err
+	}
+	return &source, nil
+}
+
+// FindByName returns the source named exactly name.
+func (s *SourcesService) FindByName(ctx context.Context, name string) (*Source, error) {
+	sources, err := s.List(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sources {
+		if sources[i].Name == name {
+			return &sources[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no source named %q", name)
+}
+
+// Source operations that can be started with SourcesService.StartOperation.
+const (
+	SourceOperationRebuild = "rebuild"
+	SourceOperationRescan  = "rescan"
+	SourceOperationRefresh = "incrementalRefresh"
+)
+
+// Source status types and operation results reported by the Platform API.
+const (
+	SourceStatusIdle      = "IDLE"
+	SourceStatusError     = "ERROR"
+	SourceOperationFailed = "ERROR"
+)
+
+// SourceStatus is the current activity of a source and the outcome of its
+// last operation.
+type SourceStatus struct {
+	Type          string           `json:"type"`
+	LastOperation *SourceOperation `json:"lastOperation"`
+}
+
+// SourceOperation is a rebuild, rescan or refresh of a source.
+type SourceOperation struct {
+	ID            string `json:"id"`
+	OperationType string `json:"operationType"`
+	Result        string `json:"result"`
+	ErrorCode     string `json:"errorCode"`
+	ErrorDetails  string `json:"errorDetails"`
+	EndTimestamp  int64  `json:"endTimestamp"`
+}
+
+// Status returns the status of the source with the given ID.
+func (s *SourcesService) Status(ctx context.Context, id string) (*SourceStatus, error) {
+	var status SourceStatus
+	endpoint := fmt.Sprintf("sources/%s/status", url.PathEscape(id))
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL(endpoint, nil), nil, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// StartOperation starts a rebuild, rescan or refresh of the source with the
+// given ID. The operation runs asynchronously; use Status to follow it.
+func (s *SourcesService) StartOperation(ctx context.Context, id, operation string) error {
+	endpoint := fmt.Sprintf("sources/%s/%s", url.PathEscape(id), operation)
+	return s.client.do(ctx, "POST", s.client.PlatformEndpointURL(endpoint, nil), nil, nil)
+}
+
+// SourceCredentials are the secrets a source uses to crawl its content. The
+// API never returns Password or Token.
+type SourceCredentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Token    string `json:"token,omitempty"`
+}
+
+func credentialsEndpoint(sourceID string) string {
+	return fmt.Sprintf("sources/%s/credentials", url.PathEscape(sourceID))
+}
+
+// GetCredentials returns the credentials of the source sourceID, without
+// their secrets.
+func (s *SourcesService) GetCredentials(ctx context.Context, sourceID string) (*SourceCredentials, error) {
+	var creds SourceCredentials
+	if err := s.client.do(ctx, "GET", s.client.PlatformEndpointURL(credentialsEndpoint(sourceID), nil), nil, &creds); err != nil {
+		return nil, err
+	}
+	return &creds, nil
+}
+
+// PutCredentials replaces the credentials of the source sourceID.
+func (s *SourcesService) PutCredentials(ctx context.Context, sourceID string, creds SourceCredentials) error {
+	return s.client.do(ctx, "PUT", s.client.PlatformEndpointURL(credentialsEndpoint(sourceID), nil), creds, nil)
+}
+
+// DeleteCredentials removes the credentials of the source sourceID.
+func (s *SourcesService) DeleteCredentials(ctx context.Context, sourceID string) error {
+	return s.client.do(ctx, "DELETE", s.client.PlatformEndpointURL(credentialsEndpoint(sourceID), nil), nil, nil)
+}
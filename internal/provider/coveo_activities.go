@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+// activitiesPageSize is the number of activities requested per page.
+const activitiesPageSize = 100
+
+// coveoActivitiesQuery filters the activities returned by the Activity API.
+// Empty fields are not filtered on.
+type coveoActivitiesQuery struct {
+	From          time.Time `json:"-"`
+	To            time.Time `json:"-"`
+	ResourceTypes []string  `json:"resourceTypes,omitempty"`
+	Operations    []string  `json:"operations,omitempty"`
+	TriggeredBy   []string  `json:"triggeredBy,omitempty"`
+}
+
+// coveoActivity is an audit event of the organization returned by the
+// Activity API.
+type coveoActivity struct {
+	ID           string `json:"id"`
+	CreateDate   int64  `json:"createDate"`
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceId"`
+	ResourceName string `json:"resourceName"`
+	Operation    string `json:"operation"`
+	Result       string `json:"result"`
+	Section      string `json:"section"`
+	TriggeredBy  struct {
+		ID          string `json:"id"`
+		Type        string `json:"type"`
+		DisplayName string `json:"displayName"`
+	} `json:"triggeredBy"`
+}
+
+// ListActivities returns every activity matching query, oldest first,
+// requesting pages until the Activity API reports none are left.
+func (c *CoveoClient) ListActivities(ctx context.Context, query coveoActivitiesQuery) ([]coveoActivity, error) {
+	params := url.Values{}
+	params.Set("from", query.From.UTC().Format(time.RFC3339))
+	params.Set("to", query.To.UTC().Format(time.RFC3339))
+	params.Set("order", "asc")
+
+	return coveo.ListAll(ctx, activitiesPageSize, func(ctx context.Context, req coveo.PageRequest) (*coveo.Page[coveoActivity], error) {
+		body, err := c.DoPlatformRequest(ctx, "POST", "activities?"+coveo.PageQuery(params, req).Encode(), query)
+		if err != nil {
+			return nil, err
+		}
+		return coveo.DecodePage[coveoActivity](body, "items")
+	})
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCoveoClient_ListActivities(t *testing.T) {
+	var pages []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/organizations/myorg/activities" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var filter coveoActivitiesQuery
+		if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+			t.Fatal(err)
+		}
+		if len(filter.Operations) != 1 || filter.Operations[0] != "DELETE" {
+			t.Errorf("unexpected filter %+v", filter)
+		}
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		fmt.Fprintf(w, `{"items":[{"id":"a%s","operation":"DELETE"}],"totalPages":2}`, page)
+	})
+
+	activities, err := client.ListActivities(context.Background(), coveoActivitiesQuery{
+		From:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:         time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		Operations: []string{"DELETE"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(activities) != 2 || activities[0].ID != "a0" || activities[1].ID != "a1" {
+		t.Errorf("unexpected activities %+v", activities)
+	}
+	if len(pages) != 2 {
+		t.Errorf("requested pages %v, want 0 and 1", pages)
+	}
+}
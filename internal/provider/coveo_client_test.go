@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// newTestClient returns a CoveoClient whose API families all point at a
+// test server running handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *CoveoClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewCoveoClient("xx-test-key", "myorg", ClientOptions{UserAgent: userAgent("test")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.APIURL = server.URL
+	client.PlatformURL = server.URL
+	return client
+}
+
+// testResourceSchema returns the schema of r.
+func testResourceSchema(ctx context.Context, r resource.Resource) schema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// testObjectValue returns a value of the resource or data source schema s
+// with the given attributes and every other attribute null, to build configs,
+// plans and states.
+func testObjectValue(ctx context.Context, s interface{ Type() attr.Type }, values map[string]tftypes.Value) tftypes.Value {
+	objType := s.Type().TerraformType(ctx).(tftypes.Object)
+	all := map[string]tftypes.Value{}
+	for name, typ := range objType.AttributeTypes {
+		all[name] = tftypes.NewValue(typ, nil)
+	}
+	for name, v := range values {
+		all[name] = v
+	}
+	return tftypes.NewValue(objType, all)
+}
+
+func TestCoveoClient_DoPlatformRequest(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer xx-test-key" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "terraform-provider-coveo/test" {
+			t.Errorf("User-Agent = %q", got)
+		}
+		fmt.Fprint(w, `{"id":"myorg","displayName":"My Org","readOnly":true,"createdDate":1700000000000}`)
+	})
+
+	org, err := readOrganization(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if org.DisplayName != "My Org" || !org.ReadOnly {
+		t.Errorf("unexpected organization %+v", org)
+	}
+	if got, want := formatEpochMillis(org.CreatedDate), "2023-11-14T22:13:20Z"; got != want {
+		t.Errorf("created date = %s, want %s", got, want)
+	}
+}
+
+func TestCoveoClient_NotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+
+	_, err := client.DoPlatformRequest(context.Background(), "GET", "sources/missing", nil)
+	if !isNotFound(err) {
+		t.Errorf("isNotFound(%v) = false, want true", err)
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+// logsPageSize is the number of log entries requested per page.
+const logsPageSize = 100
+
+// coveoLogsQuery filters the entries returned by the Logs API. Empty fields
+// are not filtered on.
+type coveoLogsQuery struct {
+	From          time.Time `json:"-"`
+	To            time.Time `json:"-"`
+	SourcesIDs    []string  `json:"sourcesIds,omitempty"`
+	Operations    []string  `json:"operations,omitempty"`
+	Results       []string  `json:"results,omitempty"`
+	DocumentsURIs []string  `json:"documentsUris,omitempty"`
+}
+
+// coveoLogEntry is an indexing log entry returned by the Logs API.
+type coveoLogEntry struct {
+	ID         string `json:"id"`
+	Datetime   string `json:"datetime"`
+	Task       string `json:"task"`
+	Operation  string `json:"operation"`
+	Result     string `json:"result"`
+	ResourceID string `json:"resourceId"`
+	SourceID   string `json:"sourceId"`
+	Meta       struct {
+		Error string `json:"error"`
+	} `json:"meta"`
+}
+
+// queryLogs returns every log entry matching query, requesting pages until
+// the Logs API returns a short one.
+func queryLogs(ctx context.Context, client *CoveoClient, query coveoLogsQuery) ([]coveoLogEntry, error) {
+	params := url.Values{}
+	params.Set("from", query.From.UTC().Format(time.RFC3339))
+	params.Set("to", query.To.UTC().Format(time.RFC3339))
+
+	return coveo.ListAll(ctx, logsPageSize, func(ctx context.Context, req coveo.PageRequest) (*coveo.Page[coveoLogEntry], error) {
+		body, err := client.DoLogsRequest(ctx, coveo.PageQuery(params, req), query)
+		if err != nil {
+			return nil, err
+		}
+		return coveo.DecodePage[coveoLogEntry](body, "")
+	})
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestQueryLogs(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/logs/v1/organizations/myorg" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("from"); got != "2024-05-01T00:00:00Z" {
+			t.Errorf("from = %q", got)
+		}
+		if got := r.URL.Query().Get("to"); got != "2024-05-01T01:00:00Z" {
+			t.Errorf("to = %q", got)
+		}
+		var body map[string][]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if len(body["sourcesIds"]) != 1 || body["sourcesIds"][0] != "src" {
+			t.Errorf("sourcesIds = %v", body["sourcesIds"])
+		}
+		if len(body["results"]) != 1 || body["results"][0] != "ERROR" {
+			t.Errorf("results = %v", body["results"])
+		}
+		if _, ok := body["operations"]; ok {
+			t.Errorf("empty filters should be omitted, got operations %v", body["operations"])
+		}
+		fmt.Fprint(w, `[{"id":"l1","task":"MAPPING","operation":"ADD","result":"ERROR","resourceId":"https://docs/1","meta":{"error":"invalid mapping"}}]`)
+	})
+
+	entries, err := queryLogs(context.Background(), client, coveoLogsQuery{
+		From:       from,
+		To:         to,
+		SourcesIDs: []string{"src"},
+		Results:    []string{"ERROR"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].ResourceID != "https://docs/1" || entries[0].Meta.Error != "invalid mapping" {
+		t.Errorf("unexpected entries %+v", entries)
+	}
+}
+
+func TestQueryLogs_Pages(t *testing.T) {
+	var pages []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("perPage") != fmt.Sprint(logsPageSize) {
+			t.Errorf("perPage = %q, want %d", q.Get("perPage"), logsPageSize)
+		}
+		page := q.Get("page")
+		pages = append(pages, page)
+
+		n := logsPageSize
+		if page == "1" {
+			n = 3
+		}
+		entries := make([]coveoLogEntry, n)
+		for i := range entries {
+			entries[i].ID = fmt.Sprintf("p%s-%d", page, i)
+		}
+		if err := json.NewEncoder(w).Encode(entries); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	entries, err := queryLogs(context.Background(), client, coveoLogsQuery{From: time.Now().Add(-time.Hour), To: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != logsPageSize+3 || fmt.Sprint(pages) != "[0 1]" {
+		t.Errorf("got %d entries from pages %v, want %d from [0 1]", len(entries), pages, logsPageSize+3)
+	}
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CoveoActivitiesDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoActivitiesDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoActivitiesDataSource{client: client}
+}
+
+type coveoActivitiesDataSourceModel struct {
+	From          types.String                    `tfsdk:"from"`
+	To            types.String                    `tfsdk:"to"`
+	ResourceTypes []string                        `tfsdk:"resource_types"`
+	Operations    []string                        `tfsdk:"operations"`
+	Users         []string                        `tfsdk:"users"`
+	Activities    []coveoActivitiesDataSourceItem `tfsdk:"activities"`
+}
+
+type coveoActivitiesDataSourceItem struct {
+	ID           types.String `tfsdk:"id"`
+	CreatedDate  types.String `tfsdk:"created_date"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	ResourceName types.String `tfsdk:"resource_name"`
+	Operation    types.String `tfsdk:"operation"`
+	Result       types.String `tfsdk:"result"`
+	Section      types.String `tfsdk:"section"`
+	User         types.String `tfsdk:"user"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoActivitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_activities"
+}
+
+// Schema defines the schema for the activities data source.
+func (d *CoveoActivitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the activities of the Coveo organization, the audit trail of who changed what.",
+		Attributes: map[string]schema.Attribute{
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "The start of the time range, in RFC 3339 format.",
+			},
+			"to": schema.StringAttribute{
+				Required:    true,
+				Description: "The end of the time range, in RFC 3339 format.",
+			},
+			"resource_types": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return activities on these resource types, such as SOURCE or API_KEY.",
+			},
+			"operations": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return these operations, such as CREATE, UPDATE or DELETE.",
+			},
+			"users": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return activities triggered by these users or API keys.",
+			},
+			"activities": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching activities, oldest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The activity ID.",
+						},
+						"created_date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the activity happened, in RFC 3339 format.",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the resource acted on.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the resource acted on.",
+						},
+						"resource_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the resource acted on.",
+						},
+						"operation": schema.StringAttribute{
+							Computed:    true,
+							Description: "The operation performed.",
+						},
+						"result": schema.StringAttribute{
+							Computed:    true,
+							Description: "The result of the operation, such as SUCCESS or ERROR.",
+						},
+						"section": schema.StringAttribute{
+							Computed:    true,
+							Description: "The section of the Administration Console the resource belongs to.",
+						},
+						"user": schema.StringAttribute{
+							Computed:    true,
+							Description: "The user or API key that triggered the activity.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read lists the activities.
+func (d *CoveoActivitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var state coveoActivitiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, err := time.Parse(time.RFC3339, state.From.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("from"), "Invalid Configuration", fmt.Sprintf("from must be an RFC 3339 date: %s", err))
+		return
+	}
+	to, err := time.Parse(time.RFC3339, state.To.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid Configuration", fmt.Sprintf("to must be an RFC 3339 date: %s", err))
+		return
+	}
+
+	activities, err := d.client.ListActivities(ctx, coveoActivitiesQuery{
+		From:          from,
+		To:            to,
+		ResourceTypes: state.ResourceTypes,
+		Operations:    state.Operations,
+		TriggeredBy:   state.Users,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list activities: %s", err))
+		return
+	}
+
+	state.Activities = []coveoActivitiesDataSourceItem{}
+	for _, a := range activities {
+		user := a.TriggeredBy.DisplayName
+		if user == "" {
+			user = a.TriggeredBy.ID
+		}
+		state.Activities = append(state.Activities, coveoActivitiesDataSourceItem{
+			ID:           types.StringValue(a.ID),
+			CreatedDate:  types.StringValue(formatEpochMillis(a.CreateDate)),
+			ResourceType: types.StringValue(a.ResourceType),
+			ResourceID:   types.StringValue(a.ResourceID),
+			ResourceName: types.StringValue(a.ResourceName),
+			Operation:    types.StringValue(a.Operation),
+			Result:       types.StringValue(a.Result),
+			Section:      types.StringValue(a.Section),
+			User:         types.StringValue(user),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// extensionTestOrigin is the metadata origin used for test documents.
+const extensionTestOrigin = "Terraform"
+
+// coveoExtensionTestDocument is the sample document sent to the extension
+// test endpoint.
+type coveoExtensionTestDocument struct {
+	Metadata    []coveoExtensionTestValues `json:"metadata"`
+	DataStreams []coveoExtensionTestValues `json:"dataStreams"`
+}
+
+type coveoExtensionTestValues struct {
+	Values map[string]interface{} `json:"values"`
+	Origin string                 `json:"origin"`
+}
+
+// coveoExtensionTestResult is the outcome of an extension test run.
+type coveoExtensionTestResult struct {
+	ExecutionTime float64 `json:"executionTime"`
+	Rejected      bool    `json:"rejected"`
+	LogEntries    []struct {
+		Level   string `json:"level"`
+		Comment string `json:"comment"`
+	} `json:"logEntries"`
+	OutputDocument struct {
+		Metadata []coveoExtensionTestValues `json:"metadata"`
+	} `json:"outputDocument"`
+}
+
+// testExtension runs the extension against a document made of metadata and
+// an optional body text.
+func testExtension(ctx context.Context, client *CoveoClient, extensionID string, metadata map[string]string, bodyText string) (*coveoExtensionTestResult, error) {
+	values := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		values[k] = []string{v}
+	}
+	doc := coveoExtensionTestDocument{
+		Metadata:    []coveoExtensionTestValues{{Values: values, Origin: extensionTestOrigin}},
+		DataStreams: []coveoExtensionTestValues{},
+	}
+	if bodyText != "" {
+		doc.DataStreams = append(doc.DataStreams, coveoExtensionTestValues{
+			Values: map[string]interface{}{
+				"BODY_TEXT": map[string]string{"inlineContent": base64.StdEncoding.EncodeToString([]byte(bodyText))},
+			},
+			Origin: extensionTestOrigin,
+		})
+	}
+
+	body, err := client.DoPlatformRequest(ctx, "POST", fmt.Sprintf("extensions/%s/test", url.PathEscape(extensionID)), map[string]interface{}{
+		"document": doc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result coveoExtensionTestResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// flattenMetadata merges metadata values into a single map. Single values are
+// kept as strings, multiple values are JSON-encoded.
+func flattenMetadata(metadata []coveoExtensionTestValues) map[string]string {
+	out := map[string]string{}
+	for _, m := range metadata {
+		for k, v := range m.Values {
+			if list, ok := v.([]interface{}); ok && len(list) == 1 {
+				v = list[0]
+			}
+			out[k] = rawFieldString(v)
+		}
+	}
+	return out
+}
+
+type CoveoExtensionTestDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoExtensionTestDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoExtensionTestDataSource{client: client}
+}
+
+type coveoExtensionTestDataSourceModel struct {
+	ExtensionID    types.String                 `tfsdk:"extension_id"`
+	Metadata       map[string]string            `tfsdk:"metadata"`
+	Body           types.String                 `tfsdk:"body"`
+	ResultMetadata map[string]string            `tfsdk:"result_metadata"`
+	Rejected       types.Bool                   `tfsdk:"rejected"`
+	ExecutionTime  types.Float64                `tfsdk:"execution_time"`
+	Logs           []coveoExtensionTestLogModel `tfsdk:"logs"`
+}
+
+type coveoExtensionTestLogModel struct {
+	Level   types.String `tfsdk:"level"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoExtensionTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_extension_test"
+}
+
+// Schema defines the schema for the extension test data source.
+func (d *CoveoExtensionTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an indexing pipeline extension against a sample document.",
+		Attributes: map[string]schema.Attribute{
+			"extension_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the extension to test.",
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The metadata of the sample document.",
+			},
+			"body": schema.StringAttribute{
+				Optional:    true,
+				Description: "The body text of the sample document.",
+			},
+			"result_metadata": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The metadata of the document after the extension ran. Multi-value metadata are JSON-encoded.",
+			},
+			"rejected": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the extension rejected the document.",
+			},
+			"execution_time": schema.Float64Attribute{
+				Computed:    true,
+				Description: "The execution time of the extension, in seconds.",
+			},
+			"logs": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The log entries written by the extension.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"level": schema.StringAttribute{
+							Computed:    true,
+							Description: "The log level.",
+						},
+						"comment": schema.StringAttribute{
+							Computed:    true,
+							Description: "The log message.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read runs the test.
+func (d *CoveoExtensionTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var state coveoExtensionTestDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := testExtension(ctx, d.client, state.ExtensionID.ValueString(), state.Metadata, state.Body.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to test extension: %s", err))
+		return
+	}
+
+	state.ResultMetadata = flattenMetadata(result.OutputDocument.Metadata)
+	state.Rejected = types.BoolValue(result.Rejected)
+	state.ExecutionTime = types.Float64Value(result.ExecutionTime)
+	state.Logs = []coveoExtensionTestLogModel{}
+	for _, l := range result.LogEntries {
+		state.Logs = append(state.Logs, coveoExtensionTestLogModel{
+			Level:   types.StringValue(l.Level),
+			Comment: types.StringValue(l.Comment),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTestExtension(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/organizations/myorg/extensions/ext1/test" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req struct {
+			Document coveoExtensionTestDocument `json:"document"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if got := fmt.Sprint(req.Document.Metadata[0].Values["title"]); got != "[Hello]" {
+			t.Errorf("title metadata = %s", got)
+		}
+		stream := req.Document.DataStreams[0].Values["BODY_TEXT"].(map[string]interface{})
+		if stream["inlineContent"] != base64.StdEncoding.EncodeToString([]byte("body")) {
+			t.Errorf("unexpected body stream %v", stream)
+		}
+		fmt.Fprint(w, `{
+			"executionTime": 0.5,
+			"rejected": false,
+			"logEntries": [{"level": "INFO", "comment": "done"}],
+			"outputDocument": {"metadata": [{"values": {"title": ["Hello"], "tags": ["a", "b"]}}]}
+		}`)
+	})
+
+	result, err := testExtension(context.Background(), client, "ext1", map[string]string{"title": "Hello"}, "body")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ExecutionTime != 0.5 || len(result.LogEntries) != 1 {
+		t.Errorf("unexpected result %+v", result)
+	}
+	meta := flattenMetadata(result.OutputDocument.Metadata)
+	if meta["title"] != "Hello" || meta["tags"] != `["a","b"]` {
+		t.Errorf("unexpected metadata %v", meta)
+	}
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+type CoveoFieldDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoFieldDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoFieldDataSource{client: client}
+}
+
+type coveoFieldDataSourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Description      types.String `tfsdk:"description"`
+	Facet            types.Bool   `tfsdk:"facet"`
+	MultiValueFacet  types.Bool   `tfsdk:"multi_value_facet"`
+	Sort             types.Bool   `tfsdk:"sort"`
+	IncludeInQuery   types.Bool   `tfsdk:"include_in_query"`
+	IncludeInResults types.Bool   `tfsdk:"include_in_results"`
+	System           types.Bool   `tfsdk:"system"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoFieldDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_field"
+}
+
+// Schema defines the schema for the field data source.
+func (d *CoveoFieldDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Coveo index field by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The field name, which is also its ID.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The field type, such as STRING or LONG.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The field description.",
+			},
+			"facet": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the field can be used as a facet.",
+			},
+			"multi_value_facet": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the field is a multi-value facet.",
+			},
+			"sort": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether results can be sorted on the field.",
+			},
+			"include_in_query": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the field can be referenced in queries.",
+			},
+			"include_in_results": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the field is returned in query results.",
+			},
+			"system": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the field is a Coveo system field.",
+			},
+		},
+	}
+}
+
+// Read looks the field up.
+func (d *CoveoFieldDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var state coveoFieldDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := d.client.Fields.Get(ctx, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read field: %s", err))
+		return
+	}
+
+	state.Type = types.StringValue(field.Type)
+	state.Description = types.StringValue(field.Description)
+	state.Facet = types.BoolValue(field.Facet)
+	state.MultiValueFacet = types.BoolValue(field.MultiValueFacet)
+	state.Sort = types.BoolValue(field.Sort)
+	state.IncludeInQuery = types.BoolValue(field.IncludeInQuery)
+	state.IncludeInResults = types.BoolValue(field.IncludeInResults)
+	state.System = types.BoolValue(field.System)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type CoveoFieldsDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoFieldsDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoFieldsDataSource{client: client}
+}
+
+type coveoFieldsDataSourceModel struct {
+	Filter        types.String                `tfsdk:"filter"`
+	Type          types.String                `tfsdk:"type"`
+	IncludeSystem types.Bool                  `tfsdk:"include_system"`
+	Names         []string                    `tfsdk:"names"`
+	Fields        []coveoFieldsDataSourceItem `tfsdk:"fields"`
+}
+
+type coveoFieldsDataSourceItem struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Facet       types.Bool   `tfsdk:"facet"`
+	Sort        types.Bool   `tfsdk:"sort"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_fields"
+}
+
+// Schema defines the schema for the fields data source.
+func (d *CoveoFieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Coveo index fields of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return fields whose name contains this string.",
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return fields of this type, such as STRING.",
+			},
+			"include_system": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to include Coveo system fields. Defaults to false.",
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The names of the matching fields.",
+			},
+			"fields": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching fields.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The field name.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The field type.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The field description.",
+						},
+						"facet": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the field can be used as a facet.",
+						},
+						"sort": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether results can be sorted on the field.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read lists the matching fields.
+func (d *CoveoFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var state coveoFieldsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fields, err := d.client.Fields.List(ctx, coveo.FieldListOptions{
+		Filter: state.Filter.ValueString(),
+		Type:   state.Type.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list fields: %s", err))
+		return
+	}
+
+	state.Names = []string{}
+	state.Fields = []coveoFieldsDataSourceItem{}
+	for _, field := range fields {
+		if field.System && !state.IncludeSystem.ValueBool() {
+			continue
+		}
+		state.Names = append(state.Names, field.Name)
+		state.Fields = append(state.Fields, coveoFieldsDataSourceItem{
+			Name:        types.StringValue(field.Name),
+			Type:        types.StringValue(field.Type),
+			Description: types.StringValue(field.Description),
+			Facet:       types.BoolValue(field.Facet),
+			Sort:        types.BoolValue(field.Sort),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
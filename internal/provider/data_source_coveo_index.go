@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+// findIndex looks an index up by ID or, when id is empty, returns the first
+// index serving logicalIndex.
+func findIndex(ctx context.Context, client *CoveoClient, id, logicalIndex string) (*coveo.Index, error) {
+	if id != "" {
+		return client.Indexes.Get(ctx, id)
+	}
+	return client.Indexes.FindByLogicalIndex(ctx, logicalIndex)
+}
+
+type CoveoIndexDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoIndexDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoIndexDataSource{client: client}
+}
+
+type coveoIndexDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	LogicalIndex types.String `tfsdk:"logical_index"`
+	Region       types.String `tfsdk:"region"`
+	Online       types.Bool   `tfsdk:"online"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoIndexDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_index"
+}
+
+// Schema defines the schema for the index data source.
+func (d *CoveoIndexDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Coveo index by ID or logical index name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The index ID. Exactly one of id or logical_index must be set.",
+			},
+			"logical_index": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The logical index served by the index, such as default. Exactly one of id or logical_index must be set.",
+			},
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Description: "The region hosting the index.",
+			},
+			"online": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the index is online.",
+			},
+		},
+	}
+}
+
+// Read looks the index up.
+func (d *CoveoIndexDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var config coveoIndexDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.ID.IsNull() == config.LogicalIndex.IsNull() {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of id or logical_index must be set.")
+		return
+	}
+
+	index, err := findIndex(ctx, d.client, config.ID.ValueString(), config.LogicalIndex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read index: %s", err))
+		return
+	}
+
+	state := coveoIndexDataSourceModel{
+		ID:           types.StringValue(index.ID),
+		LogicalIndex: types.StringValue(index.LogicalIndex),
+		Region:       types.StringValue(index.Region),
+		Online:       types.BoolValue(index.Online),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// coveoOrganization is the organization model returned by the Platform API.
+type coveoOrganization struct {
+	ID             string `json:"id"`
+	DisplayName    string `json:"displayName"`
+	Type           string `json:"type"`
+	Region         string `json:"region"`
+	ReadOnly       bool   `json:"readOnly"`
+	CreatedDate    int64  `json:"createdDate"`
+	// SessionTimeout is nil when the API omits it.
+	SessionTimeout *int64 `json:"sessionTimeout,omitempty"`
+}
+
+// coveoLicense is the subset of the organization license the provider exposes.
+type coveoLicense struct {
+	Type           string `json:"type"`
+	ExpirationDate int64  `json:"expirationDate"`
+}
+
+// readOrganization fetches the organization the client is configured for.
+func readOrganization(ctx context.Context, client *CoveoClient) (*coveoOrganization, error) {
+	body, err := client.DoPlatformRequest(ctx, "GET", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var org coveoOrganization
+	if err := json.Unmarshal(body, &org); err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// formatEpochMillis renders a Coveo epoch-milliseconds timestamp as RFC 3339.
+func formatEpochMillis(ms int64) string {
+	if ms == 0 {
+		return ""
+	}
+	return time.UnixMilli(ms).UTC().Format(time.RFC3339)
+}
+
+type CoveoOrganizationDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoOrganizationDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoOrganizationDataSource{client: client}
+}
+
+type coveoOrganizationDataSourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	DisplayName           types.String `tfsdk:"display_name"`
+	Type                  types.String `tfsdk:"type"`
+	Region                types.String `tfsdk:"region"`
+	ReadOnly              types.Bool   `tfsdk:"readonly"`
+	CreatedDate           types.String `tfsdk:"created_date"`
+	LicenseType           types.String `tfsdk:"license_type"`
+	LicenseExpirationDate types.String `tfsdk:"license_expiration_date"`
+	LicenseLimits         types.Map    `tfsdk:"license_limits"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoOrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_organization"
+}
+
+// Schema defines the schema for the organization data source.
+func (d *CoveoOrganizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the Coveo organization the provider is configured for.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The organization ID.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The organization display name.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The organization type, such as PRODUCTION, SANDBOX or TRIAL.",
+			},
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Description: "The region hosting the organization.",
+			},
+			"readonly": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the organization is read-only.",
+			},
+			"created_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the organization was created, in RFC 3339 format.",
+			},
+			"license_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The organization license type.",
+			},
+			"license_expiration_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the organization license expires, in RFC 3339 format.",
+			},
+			"license_limits": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The numeric limits of the organization license, keyed by limit name.",
+			},
+		},
+	}
+}
+
+// Read fetches the organization and its license.
+func (d *CoveoOrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	org, err := readOrganization(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read organization: %s", err))
+		return
+	}
+
+	body, err := d.client.DoPlatformRequest(ctx, "GET", "license", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read organization license: %s", err))
+		return
+	}
+	var license coveoLicense
+	if err := json.Unmarshal(body, &license); err != nil {
+		resp.Diagnostics.AddError("Parse Error", "Could not parse response from Coveo API.")
+		return
+	}
+	// Every integer in the license payload is a limit (items, sources, queries
+	// per month, ...), except the expiration date.
+	var raw map[string]interface{}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		resp.Diagnostics.AddError("Parse Error", "Could not parse response from Coveo API.")
+		return
+	}
+	limits := map[string]int64{}
+	for k, v := range raw {
+		if n, ok := v.(float64); ok && k != "expirationDate" {
+			limits[k] = int64(n)
+		}
+	}
+
+	state := coveoOrganizationDataSourceModel{
+		ID:                    types.StringValue(org.ID),
+		DisplayName:           types.StringValue(org.DisplayName),
+		Type:                  types.StringValue(org.Type),
+		Region:                types.StringValue(org.Region),
+		ReadOnly:              types.BoolValue(org.ReadOnly),
+		CreatedDate:           types.StringValue(formatEpochMillis(org.CreatedDate)),
+		LicenseType:           types.StringValue(license.Type),
+		LicenseExpirationDate: types.StringValue(formatEpochMillis(license.ExpirationDate)),
+	}
+	limitsValue, diags := types.MapValueFrom(ctx, types.Int64Type, limits)
+	resp.Diagnostics.Append(diags...)
+	state.LicenseLimits = limitsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+// findQueryPipeline looks a pipeline up by ID or, when id is empty, by exact
+// name.
+func findQueryPipeline(ctx context.Context, client *CoveoClient, id, name string) (*coveo.Pipeline, error) {
+	if id != "" {
+		return client.Pipelines.Get(ctx, id)
+	}
+	return client.Pipelines.FindByName(ctx, name)
+}
+
+type CoveoQueryPipelineDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoQueryPipelineDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoQueryPipelineDataSource{client: client}
+}
+
+type coveoQueryPipelineDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IsDefault   types.Bool   `tfsdk:"is_default"`
+	ConditionID types.String `tfsdk:"condition_id"`
+	Condition   types.String `tfsdk:"condition"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoQueryPipelineDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_query_pipeline"
+}
+
+// Schema defines the schema for the query pipeline data source.
+func (d *CoveoQueryPipelineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Coveo query pipeline by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The pipeline ID. Exactly one of id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The pipeline name. Exactly one of id or name must be set.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The pipeline description.",
+			},
+			"is_default": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether this is the default pipeline of the organization.",
+			},
+			"condition_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the condition routing queries to the pipeline, if any.",
+			},
+			"condition": schema.StringAttribute{
+				Computed:    true,
+				Description: "The definition of the condition routing queries to the pipeline, if any.",
+			},
+		},
+	}
+}
+
+// Read looks the pipeline up.
+func (d *CoveoQueryPipelineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var config coveoQueryPipelineDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.ID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of id or name must be set.")
+		return
+	}
+
+	pipeline, err := findQueryPipeline(ctx, d.client, config.ID.ValueString(), config.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read query pipeline: %s", err))
+		return
+	}
+
+	state := coveoQueryPipelineDataSourceModel{
+		ID:          types.StringValue(pipeline.ID),
+		Name:        types.StringValue(pipeline.Name),
+		Description: types.StringValue(pipeline.Description),
+		IsDefault:   types.BoolValue(pipeline.IsDefault),
+		ConditionID: types.StringValue(""),
+		Condition:   types.StringValue(""),
+	}
+	if pipeline.Condition != nil {
+		state.ConditionID = types.StringValue(pipeline.Condition.ID)
+		state.Condition = types.StringValue(pipeline.Condition.Definition)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// coveoSearchRequest is the body of a Search API query.
+type coveoSearchRequest struct {
+	Q               string   `json:"q,omitempty"`
+	AQ              string   `json:"aq,omitempty"`
+	CQ              string   `json:"cq,omitempty"`
+	Pipeline        string   `json:"pipeline,omitempty"`
+	SearchHub       string   `json:"searchHub,omitempty"`
+	NumberOfResults int64    `json:"numberOfResults,omitempty"`
+	FieldsToInclude []string `json:"fieldsToInclude,omitempty"`
+}
+
+// coveoSearchResponse is the subset of a Search API response the provider
+// exposes.
+type coveoSearchResponse struct {
+	TotalCount int64 `json:"totalCount"`
+	Results    []struct {
+		Title    string                 `json:"title"`
+		URI      string                 `json:"uri"`
+		ClickURI string                 `json:"clickUri"`
+		UniqueID string                 `json:"uniqueId"`
+		Raw      map[string]interface{} `json:"raw"`
+	} `json:"results"`
+}
+
+// search runs a query against the Search API.
+func search(ctx context.Context, client *CoveoClient, query coveoSearchRequest) (*coveoSearchResponse, error) {
+	body, err := client.DoSearchRequest(ctx, "POST", "", query)
+	if err != nil {
+		return nil, err
+	}
+	var resp coveoSearchResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// rawFieldString renders a raw result field value as a string. Strings are
+// kept as-is, other values (numbers, lists) are JSON-encoded.
+func rawFieldString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+type CoveoSearchDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSearchDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoSearchDataSource{client: client}
+}
+
+type coveoSearchDataSourceModel struct {
+	Q               types.String                `tfsdk:"q"`
+	AQ              types.String                `tfsdk:"aq"`
+	CQ              types.String                `tfsdk:"cq"`
+	Pipeline        types.String                `tfsdk:"pipeline"`
+	SearchHub       types.String                `tfsdk:"search_hub"`
+	NumberOfResults types.Int64                 `tfsdk:"number_of_results"`
+	Fields          []string                    `tfsdk:"fields"`
+	TotalCount      types.Int64                 `tfsdk:"total_count"`
+	Results         []coveoSearchDataSourceItem `tfsdk:"results"`
+}
+
+type coveoSearchDataSourceItem struct {
+	Title    types.String      `tfsdk:"title"`
+	URI      types.String      `tfsdk:"uri"`
+	ClickURI types.String      `tfsdk:"click_uri"`
+	UniqueID types.String      `tfsdk:"unique_id"`
+	Raw      map[string]string `tfsdk:"raw"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoSearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_search"
+}
+
+// Schema defines the schema for the search data source.
+func (d *CoveoSearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a query against the Coveo Search API.",
+		Attributes: map[string]schema.Attribute{
+			"q": schema.StringAttribute{
+				Optional:    true,
+				Description: "The basic query expression, typically the end-user keywords.",
+			},
+			"aq": schema.StringAttribute{
+				Optional:    true,
+				Description: "The advanced query expression, such as @source==docs.",
+			},
+			"cq": schema.StringAttribute{
+				Optional:    true,
+				Description: "The constant query expression, cached by the index.",
+			},
+			"pipeline": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the query pipeline to use.",
+			},
+			"search_hub": schema.StringAttribute{
+				Optional:    true,
+				Description: "The search hub the query originates from.",
+			},
+			"number_of_results": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of results to return. Defaults to the Search API default of 10.",
+			},
+			"fields": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The fields to include in the raw values of each result. All fields are returned when unset.",
+			},
+			"total_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total number of items matching the query.",
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The returned results.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							Computed:    true,
+							Description: "The result title.",
+						},
+						"uri": schema.StringAttribute{
+							Computed:    true,
+							Description: "The result URI.",
+						},
+						"click_uri": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URI to open when the result is clicked.",
+						},
+						"unique_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique ID of the result in the index.",
+						},
+						"raw": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The raw field values of the result. Non-string values are JSON-encoded.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read runs the query.
+func (d *CoveoSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var state coveoSearchDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := search(ctx, d.client, coveoSearchRequest{
+		Q:               state.Q.ValueString(),
+		AQ:              state.AQ.ValueString(),
+		CQ:              state.CQ.ValueString(),
+		Pipeline:        state.Pipeline.ValueString(),
+		SearchHub:       state.SearchHub.ValueString(),
+		NumberOfResults: state.NumberOfResults.ValueInt64(),
+		FieldsToInclude: state.Fields,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to run search query: %s", err))
+		return
+	}
+
+	state.TotalCount = types.Int64Value(result.TotalCount)
+	state.Results = []coveoSearchDataSourceItem{}
+	for _, r := range result.Results {
+		raw := make(map[string]string, len(r.Raw))
+		for k, v := range r.Raw {
+			raw[k] = rawFieldString(v)
+		}
+		state.Results = append(state.Results, coveoSearchDataSourceItem{
+			Title:    types.StringValue(r.Title),
+			URI:      types.StringValue(r.URI),
+			ClickURI: types.StringValue(r.ClickURI),
+			UniqueID: types.StringValue(r.UniqueID),
+			Raw:      raw,
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/search/v2" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("organizationId"); got != "myorg" {
+			t.Errorf("organizationId = %q, want myorg", got)
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body["aq"] != "@documentid==doc1" || body["searchHub"] != "smoke" {
+			t.Errorf("unexpected query %v", body)
+		}
+		if _, ok := body["q"]; ok {
+			t.Error("empty q should be omitted")
+		}
+		fmt.Fprint(w, `{"totalCount":1,"results":[{"title":"Doc 1","uri":"https://docs/1","raw":{"size":42,"source":"docs"}}]}`)
+	})
+
+	resp, err := search(context.Background(), client, coveoSearchRequest{AQ: "@documentid==doc1", SearchHub: "smoke"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.TotalCount != 1 || len(resp.Results) != 1 || resp.Results[0].Title != "Doc 1" {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	if got := rawFieldString(resp.Results[0].Raw["size"]); got != "42" {
+		t.Errorf("size = %q, want 42", got)
+	}
+	if got := rawFieldString(resp.Results[0].Raw["source"]); got != "docs" {
+		t.Errorf("source = %q, want docs", got)
+	}
+}
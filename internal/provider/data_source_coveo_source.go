@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+// findSource looks a source up by ID or, when id is empty, by exact name.
+func findSource(ctx context.Context, client *CoveoClient, id, name string) (*coveo.Source, error) {
+	if id != "" {
+		return client.Sources.Get(ctx, id)
+	}
+	return client.Sources.FindByName(ctx, name)
+}
+
+type CoveoSourceDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSourceDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoSourceDataSource{client: client}
+}
+
+type coveoSourceDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	SourceType        types.String `tfsdk:"source_type"`
+	SourceVisibility  types.String `tfsdk:"source_visibility"`
+	PushEnabled       types.Bool   `tfsdk:"push_enabled"`
+	NumberOfDocuments types.Int64  `tfsdk:"number_of_documents"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoSourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_source"
+}
+
+// Schema defines the schema for the source data source.
+func (d *CoveoSourceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Coveo source by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The source ID. Exactly one of id or name must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The source name. Exactly one of id or name must be set.",
+			},
+			"source_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The source type, such as PUSH or SITEMAP.",
+			},
+			"source_visibility": schema.StringAttribute{
+				Computed:    true,
+				Description: "The source visibility: PRIVATE, SECURED or SHARED.",
+			},
+			"push_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether documents can be pushed to the source.",
+			},
+			"number_of_documents": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of documents in the source.",
+			},
+		},
+	}
+}
+
+// Read looks the source up.
+func (d *CoveoSourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var config coveoSourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if config.ID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of id or name must be set.")
+		return
+	}
+
+	source, err := findSource(ctx, d.client, config.ID.ValueString(), config.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read source: %s", err))
+		return
+	}
+
+	state := coveoSourceDataSourceModel{
+		ID:                types.StringValue(source.ID),
+		Name:              types.StringValue(source.Name),
+		SourceType:        types.StringValue(source.SourceType),
+		SourceVisibility:  types.StringValue(source.SourceVisibility),
+		PushEnabled:       types.BoolValue(source.PushEnabled),
+		NumberOfDocuments: types.Int64Value(source.Information.NumberOfDocuments),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+type CoveoSourcesDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSourcesDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoSourcesDataSource{client: client}
+}
+
+type coveoSourcesDataSourceModel struct {
+	Filter     types.String                 `tfsdk:"filter"`
+	SourceType types.String                 `tfsdk:"source_type"`
+	IDs        []string                     `tfsdk:"ids"`
+	Sources    []coveoSourcesDataSourceItem `tfsdk:"sources"`
+}
+
+type coveoSourcesDataSourceItem struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	SourceType       types.String `tfsdk:"source_type"`
+	SourceVisibility types.String `tfsdk:"source_visibility"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoSourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_sources"
+}
+
+// Schema defines the schema for the sources data source.
+func (d *CoveoSourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Coveo sources of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return sources whose name contains this string.",
+			},
+			"source_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return sources of this type, such as PUSH.",
+			},
+			"ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the matching sources.",
+			},
+			"sources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching sources.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The source ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The source name.",
+						},
+						"source_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The source type.",
+						},
+						"source_visibility": schema.StringAttribute{
+							Computed:    true,
+							Description: "The source visibility.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read lists the matching sources.
+func (d *CoveoSourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var state coveoSourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, err := d.client.Sources.List(ctx, state.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list sources: %s", err))
+		return
+	}
+
+	state.IDs = []string{}
+	state.Sources = []coveoSourcesDataSourceItem{}
+	for _, source := range sources {
+		if !state.SourceType.IsNull() && source.SourceType != state.SourceType.ValueString() {
+			continue
+		}
+		state.IDs = append(state.IDs, source.ID)
+		state.Sources = append(state.Sources, coveoSourcesDataSourceItem{
+			ID:               types.StringValue(source.ID),
+			Name:             types.StringValue(source.Name),
+			SourceType:       types.StringValue(source.SourceType),
+			SourceVisibility: types.StringValue(source.SourceVisibility),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSourceWithValidateConfig = &CoveoSourceLogsDataSource{}
+
+// logOperations lists the document operations recorded in the source logs.
+var logOperations = []string{"ADD", "DELETE"}
+
+// logResults lists the results of a logged operation.
+var logResults = []string{"COMPLETED", "WARNING", "ERROR"}
+
+type CoveoSourceLogsDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSourceLogsDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoSourceLogsDataSource{client: client}
+}
+
+type coveoSourceLogsDataSourceModel struct {
+	SourceID     types.String                    `tfsdk:"source_id"`
+	From         types.String                    `tfsdk:"from"`
+	To           types.String                    `tfsdk:"to"`
+	Operations   []string                        `tfsdk:"operations"`
+	Results      []string                        `tfsdk:"results"`
+	DocumentURIs []string                        `tfsdk:"document_uris"`
+	Entries      []coveoSourceLogsDataSourceItem `tfsdk:"entries"`
+}
+
+type coveoSourceLogsDataSourceItem struct {
+	ID          types.String `tfsdk:"id"`
+	Date        types.String `tfsdk:"date"`
+	Task        types.String `tfsdk:"task"`
+	Operation   types.String `tfsdk:"operation"`
+	Result      types.String `tfsdk:"result"`
+	DocumentURI types.String `tfsdk:"document_uri"`
+	Error       types.String `tfsdk:"error"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoSourceLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_source_logs"
+}
+
+// Schema defines the schema for the source logs data source.
+func (d *CoveoSourceLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the indexing log entries of a Coveo source, to troubleshoot documents that were pushed but are not searchable.",
+		Attributes: map[string]schema.Attribute{
+			"source_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the source.",
+			},
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "The start of the time range, in RFC 3339 format.",
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "The end of the time range, in RFC 3339 format. Defaults to now.",
+			},
+			"operations": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Only return these operations: %s.", strings.Join(logOperations, ", ")),
+			},
+			"results": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: fmt.Sprintf("Only return these results: %s.", strings.Join(logResults, ", ")),
+			},
+			"document_uris": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Only return entries about these documents, such as the document_id of a coveo_document.",
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching log entries.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The log entry ID.",
+						},
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "When the entry was logged.",
+						},
+						"task": schema.StringAttribute{
+							Computed:    true,
+							Description: "The indexing stage that logged the entry, such as STREAMING_EXTENSION.",
+						},
+						"operation": schema.StringAttribute{
+							Computed:    true,
+							Description: "The document operation, ADD or DELETE.",
+						},
+						"result": schema.StringAttribute{
+							Computed:    true,
+							Description: "The result of the operation: COMPLETED, WARNING or ERROR.",
+						},
+						"document_uri": schema.StringAttribute{
+							Computed:    true,
+							Description: "The URI of the document.",
+						},
+						"error": schema.StringAttribute{
+							Computed:    true,
+							Description: "The error reported for the document, if any.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the dates and the operations and results filters.
+func (d *CoveoSourceLogsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	for _, attr := range []string{"from", "to"} {
+		var v types.String
+		diags := req.Config.GetAttribute(ctx, path.Root(attr), &v)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid Configuration", fmt.Sprintf("%s must be an RFC 3339 date: %s.", attr, err))
+		}
+	}
+
+	filters := []struct {
+		attr    string
+		allowed []string
+	}{
+		{"operations", logOperations},
+		{"results", logResults},
+	}
+	for _, f := range filters {
+		attr, allowed := f.attr, f.allowed
+		var list types.List
+		diags := req.Config.GetAttribute(ctx, path.Root(attr), &list)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || list.IsNull() || list.IsUnknown() {
+			continue
+		}
+		for _, elem := range list.Elements() {
+			v, ok := elem.(types.String)
+			if !ok || v.IsNull() || v.IsUnknown() || oneOf(v.ValueString(), allowed) {
+				continue
+			}
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid Configuration",
+				fmt.Sprintf("%s must be among %s, got %q.", attr, strings.Join(allowed, ", "), v.ValueString()))
+		}
+	}
+}
+
+// Read queries the source logs.
+func (d *CoveoSourceLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var state coveoSourceLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, err := time.Parse(time.RFC3339, state.From.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("from"), "Invalid Configuration", fmt.Sprintf("from must be an RFC 3339 date: %s", err))
+		return
+	}
+	to := time.Now()
+	if !state.To.IsNull() {
+		if to, err = time.Parse(time.RFC3339, state.To.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid Configuration", fmt.Sprintf("to must be an RFC 3339 date: %s", err))
+			return
+		}
+	}
+
+	entries, err := queryLogs(ctx, d.client, coveoLogsQuery{
+		From:          from,
+		To:            to,
+		SourcesIDs:    []string{state.SourceID.ValueString()},
+		Operations:    state.Operations,
+		Results:       state.Results,
+		DocumentsURIs: state.DocumentURIs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to query source logs: %s", err))
+		return
+	}
+
+	state.Entries = []coveoSourceLogsDataSourceItem{}
+	for _, e := range entries {
+		state.Entries = append(state.Entries, coveoSourceLogsDataSourceItem{
+			ID:          types.StringValue(e.ID),
+			Date:        types.StringValue(e.Datetime),
+			Task:        types.StringValue(e.Task),
+			Operation:   types.StringValue(e.Operation),
+			Result:      types.StringValue(e.Result),
+			DocumentURI: types.StringValue(e.ResourceID),
+			Error:       types.StringValue(e.Meta.Error),
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
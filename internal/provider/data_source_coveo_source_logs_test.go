@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoSourceLogsDataSource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	d := &CoveoSourceLogsDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	list := func(values ...string) tftypes.Value {
+		elems := make([]tftypes.Value, 0, len(values))
+		for _, v := range values {
+			elems = append(elems, tftypes.NewValue(tftypes.String, v))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elems)
+	}
+
+	cases := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"valid filters":     {values: map[string]tftypes.Value{"operations": list("ADD"), "results": list("WARNING", "ERROR")}, wantErr: false},
+		"no filters":        {values: map[string]tftypes.Value{}, wantErr: false},
+		"unknown operation": {values: map[string]tftypes.Value{"operations": list("ADD", "UPDATE")}, wantErr: true},
+		"unknown result":    {values: map[string]tftypes.Value{"results": list("FAILED")}, wantErr: true},
+		"unknown list":      {values: map[string]tftypes.Value{"results": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)}, wantErr: false},
+		"invalid to":        {values: map[string]tftypes.Value{"to": tftypes.NewValue(tftypes.String, "yesterday")}, wantErr: true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			c.values["source_id"] = tftypes.NewValue(tftypes.String, "src")
+			c.values["from"] = tftypes.NewValue(tftypes.String, "2024-05-01T00:00:00Z")
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: testObjectValue(ctx, schemaResp.Schema, c.values)}
+
+			var resp datasource.ValidateConfigResponse
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: config}, &resp)
+			if got := resp.Diagnostics.HasError(); got != c.wantErr {
+				t.Errorf("HasError() = %v, want %v: %v", got, c.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCoveoSourceLogsDataSource_ValidateConfigReportsEveryFilter(t *testing.T) {
+	ctx := context.Background()
+	d := &CoveoSourceLogsDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	list := func(v string) tftypes.Value {
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, v)})
+	}
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: testObjectValue(ctx, schemaResp.Schema, map[string]tftypes.Value{
+		"source_id":  tftypes.NewValue(tftypes.String, "src"),
+		"from":       tftypes.NewValue(tftypes.String, "last week"),
+		"operations": list("UPDATE"),
+		"results":    list("FAILED"),
+	})}
+	var resp datasource.ValidateConfigResponse
+	d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: config}, &resp)
+
+	got := map[string]bool{}
+	for _, diag := range resp.Diagnostics.Errors() {
+		if withPath, ok := diag.(interface{ Path() path.Path }); ok {
+			got[withPath.Path().String()] = true
+		}
+	}
+	for _, attr := range []string{"from", "operations", "results"} {
+		if !got[attr] {
+			t.Errorf("no error reported for %s: %v", attr, resp.Diagnostics)
+		}
+	}
+}
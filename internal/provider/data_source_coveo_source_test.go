@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFindSource_ByName(t *testing.T) {
+	pages := map[string]string{
+		"0": `{"sourceModels":[{"id":"s1","name":"docs-staging"}],"totalEntries":2}`,
+		"1": `{"sourceModels":[{"id":"s2","name":"docs","sourceType":"PUSH"}],"totalEntries":2}`,
+	}
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/rest/organizations/myorg/sources/page/detailed" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("filter"); got != "docs" {
+			t.Errorf("filter = %q, want docs", got)
+		}
+		fmt.Fprint(w, pages[r.URL.Query().Get("page")])
+	})
+
+	source, err := findSource(context.Background(), client, "", "docs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source.ID != "s2" || source.SourceType != "PUSH" {
+		t.Errorf("unexpected source %+v", source)
+	}
+	if requests != 2 {
+		t.Errorf("made %d requests, want 2", requests)
+	}
+}
+
+func TestFindSource_NoMatch(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"sourceModels":[{"id":"s1","name":"docs-staging"}],"totalEntries":1}`)
+	})
+
+	if _, err := findSource(context.Background(), client, "", "docs"); err == nil {
+		t.Error("expected an error when no source has the exact name")
+	}
+}
@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// coveoUAStatisticsQuery selects the usage analytics statistics to read.
+type coveoUAStatisticsQuery struct {
+	From       string
+	To         string
+	Dimensions []string
+	Metrics    []string
+	Filter     string
+	Limit      int64
+}
+
+// coveoUAStatisticsRow holds the metric values of one dimension combination.
+type coveoUAStatisticsRow struct {
+	Dimensions map[string]string
+	Metrics    map[string]float64
+}
+
+// coveoUAStatistics is the result of a statistics query. Rows is only set when
+// the query has dimensions.
+type coveoUAStatistics struct {
+	Totals map[string]float64
+	Rows   []coveoUAStatisticsRow
+}
+
+// queryUAStatistics reads combined statistics from the UA Read API. The API
+// returns keys in its own case, so they are matched case-insensitively against
+// the requested dimensions and metrics and reported under the requested names.
+func queryUAStatistics(ctx context.Context, client *CoveoClient, q coveoUAStatisticsQuery) (*coveoUAStatistics, error) {
+	query := url.Values{}
+	query.Set("from", q.From)
+	query.Set("to", q.To)
+	for _, m := range q.Metrics {
+		query.Add("m", m)
+	}
+	for _, d := range q.Dimensions {
+		query.Add("d", d)
+	}
+	if q.Filter != "" {
+		query.Set("f", q.Filter)
+	}
+	if q.Limit > 0 {
+		query.Set("n", strconv.FormatInt(q.Limit, 10))
+	}
+
+	body, err := client.DoUARequest(ctx, "GET", "stats/combinedData", query, nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		CombinedData map[string]interface{}   `json:"combinedData"`
+		Combinations []map[string]interface{} `json:"combinations"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+
+	stats := &coveoUAStatistics{Totals: metricValues(resp.CombinedData, q.Metrics)}
+	if len(q.Dimensions) > 0 {
+		stats.Rows = []coveoUAStatisticsRow{}
+		for _, c := range resp.Combinations {
+			row := coveoUAStatisticsRow{
+				Dimensions: map[string]string{},
+				Metrics:    metricValues(c, q.Metrics),
+			}
+			for _, d := range q.Dimensions {
+				if v, ok := lookupFold(c, d); ok {
+					row.Dimensions[d] = rawFieldString(v)
+				}
+			}
+			stats.Rows = append(stats.Rows, row)
+		}
+	}
+	return stats, nil
+}
+
+// metricValues extracts the numeric values of metrics from values.
+func metricValues(values map[string]interface{}, metrics []string) map[string]float64 {
+	out := map[string]float64{}
+	for _, m := range metrics {
+		if v, ok := lookupFold(values, m); ok {
+			if f, ok := v.(float64); ok {
+				out[m] = f
+			}
+		}
+	}
+	return out
+}
+
+func lookupFold(values map[string]interface{}, key string) (interface{}, bool) {
+	if v, ok := values[key]; ok {
+		return v, true
+	}
+	for k, v := range values {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+type CoveoUAStatisticsDataSource struct {
+	client *CoveoClient
+}
+
+func NewCoveoUAStatisticsDataSource(client *CoveoClient) datasource.DataSource {
+	return &CoveoUAStatisticsDataSource{client: client}
+}
+
+type coveoUAStatisticsDataSourceModel struct {
+	From       types.String                     `tfsdk:"from"`
+	To         types.String                     `tfsdk:"to"`
+	Dimensions []string                         `tfsdk:"dimensions"`
+	Metrics    []string                         `tfsdk:"metrics"`
+	Filter     types.String                     `tfsdk:"filter"`
+	Limit      types.Int64                      `tfsdk:"limit"`
+	Totals     map[string]float64               `tfsdk:"totals"`
+	Rows       []coveoUAStatisticsDataSourceRow `tfsdk:"rows"`
+}
+
+type coveoUAStatisticsDataSourceRow struct {
+	Dimensions map[string]string  `tfsdk:"dimensions"`
+	Metrics    map[string]float64 `tfsdk:"metrics"`
+}
+
+// Metadata sets the data source type name.
+func (d *CoveoUAStatisticsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "coveo_ua_statistics"
+}
+
+// Schema defines the schema for the usage analytics statistics data source.
+func (d *CoveoUAStatisticsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads usage analytics statistics for a time range, either combined or per dimension value.",
+		Attributes: map[string]schema.Attribute{
+			"from": schema.StringAttribute{
+				Required:    true,
+				Description: "The start of the time range, in RFC 3339 format.",
+			},
+			"to": schema.StringAttribute{
+				Required:    true,
+				Description: "The end of the time range, in RFC 3339 format.",
+			},
+			"metrics": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The metrics to compute, such as PerformSearch or ClickThroughRatio.",
+			},
+			"dimensions": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The dimensions to group the metrics by, such as QUERYEXPRESSION. When unset only totals are returned.",
+			},
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "A filter on the events, such as (ORIGINLEVEL1=='community').",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of rows to return.",
+			},
+			"totals": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Float64Type,
+				Description: "The metric values over the whole time range, keyed by metric.",
+			},
+			"rows": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The metric values per combination of dimension values, in the order returned by the API.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"dimensions": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The dimension values of the row, keyed by dimension.",
+						},
+						"metrics": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.Float64Type,
+							Description: "The metric values of the row, keyed by metric.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read runs the statistics query.
+func (d *CoveoUAStatisticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var state coveoUAStatisticsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stats, err := queryUAStatistics(ctx, d.client, coveoUAStatisticsQuery{
+		From:       state.From.ValueString(),
+		To:         state.To.ValueString(),
+		Dimensions: state.Dimensions,
+		Metrics:    state.Metrics,
+		Filter:     state.Filter.ValueString(),
+		Limit:      state.Limit.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read usage analytics statistics: %s", err))
+		return
+	}
+
+	state.Totals = stats.Totals
+	state.Rows = []coveoUAStatisticsDataSourceRow{}
+	for _, r := range stats.Rows {
+		state.Rows = append(state.Rows, coveoUAStatisticsDataSourceRow{
+			Dimensions: r.Dimensions,
+			Metrics:    r.Metrics,
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestQueryUAStatistics(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/ua/v15/stats/combinedData" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("org") != "myorg" || q.Get("d") != "queryExpression" || q.Get("n") != "2" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{
+			"combinedData": {"PERFORMSEARCH": 30},
+			"combinations": [
+				{"QUERYEXPRESSION": "pricing", "PERFORMSEARCH": 20},
+				{"QUERYEXPRESSION": "login", "PERFORMSEARCH": 10}
+			]
+		}`)
+	})
+
+	stats, err := queryUAStatistics(context.Background(), client, coveoUAStatisticsQuery{
+		From:       "2026-01-01T00:00:00Z",
+		To:         "2026-02-01T00:00:00Z",
+		Dimensions: []string{"queryExpression"},
+		Metrics:    []string{"PerformSearch"},
+		Limit:      2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Totals["PerformSearch"] != 30 {
+		t.Errorf("totals = %v", stats.Totals)
+	}
+	if len(stats.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(stats.Rows))
+	}
+	if r := stats.Rows[0]; r.Dimensions["queryExpression"] != "pricing" || r.Metrics["PerformSearch"] != 20 {
+		t.Errorf("unexpected first row %+v", r)
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// indexingPollInterval is the delay between two checks while waiting for a
+// pushed document to be indexed.
+var indexingPollInterval = 5 * time.Second
+
+// defaultIndexingTimeout is used when wait_for_indexing is set without an
+// explicit indexing_timeout.
+const defaultIndexingTimeout = "5m"
+
+// waitForDocumentIndexed polls the Search API until documentID is searchable.
+// Between polls it checks the source logs and fails as soon as indexing of the
+// document reported an error since pushedAt.
+func waitForDocumentIndexed(ctx context.Context, client *CoveoClient, sourceID, documentID string, pushedAt time.Time, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	query := coveoSearchRequest{
+		AQ:              fmt.Sprintf("@documentid==%q", documentID),
+		NumberOfResults: 1,
+	}
+	ticker := time.NewTicker(indexingPollInterval)
+	defer ticker.Stop()
+
+	for {
+		result, err := search(ctx, client, query)
+		if err != nil && ctx.Err() == nil {
+			return fmt.Errorf("searching for document: %w", err)
+		}
+		if err == nil && result.TotalCount > 0 {
+			return nil
+		}
+
+		entries, err := queryLogs(ctx, client, coveoLogsQuery{
+			From:          pushedAt,
+			To:            time.Now(),
+			SourcesIDs:    []string{sourceID},
+			DocumentsURIs: []string{documentID},
+			Results:       []string{"ERROR"},
+		})
+		if err != nil && ctx.Err() == nil {
+			// Logs are only used to fail fast; keep polling the index.
+			tflog.Warn(ctx, "Could not read source logs while waiting for indexing", map[string]interface{}{"error": err.Error()})
+		}
+		if len(entries) > 0 {
+			return indexingError(documentID, entries)
+		}
+
+		tflog.Debug(ctx, "Document not indexed yet", map[string]interface{}{"document_id": documentID})
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("document %s was not indexed within %s", documentID, timeout)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// indexingError summarizes the errors reported in the source logs.
+func indexingError(documentID string, entries []coveoLogEntry) error {
+	var msgs []string
+	for _, e := range entries {
+		msg := fmt.Sprintf("%s %s", e.Task, e.Operation)
+		if e.Meta.Error != "" {
+			msg = fmt.Sprintf("%s: %s", msg, e.Meta.Error)
+		}
+		msgs = append(msgs, msg)
+	}
+	return fmt.Errorf("indexing of document %s failed: %s", documentID, strings.Join(msgs, "; "))
+}
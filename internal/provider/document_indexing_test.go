@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForDocumentIndexed(t *testing.T) {
+	indexingPollInterval = time.Millisecond
+	t.Cleanup(func() { indexingPollInterval = 5 * time.Second })
+
+	var searches int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/rest/search/v2"):
+			searches++
+			if searches < 3 {
+				fmt.Fprint(w, `{"totalCount":0,"results":[]}`)
+				return
+			}
+			fmt.Fprint(w, `{"totalCount":1,"results":[{"title":"Doc"}]}`)
+		case strings.HasPrefix(r.URL.Path, "/logs/v1/organizations/myorg"):
+			fmt.Fprint(w, `[]`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+
+	err := waitForDocumentIndexed(context.Background(), client, "src", "https://docs/1", time.Now(), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if searches != 3 {
+		t.Errorf("searched %d times, want 3", searches)
+	}
+}
+
+func TestWaitForDocumentIndexed_LogError(t *testing.T) {
+	indexingPollInterval = time.Millisecond
+	t.Cleanup(func() { indexingPollInterval = 5 * time.Second })
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/logs/") {
+			fmt.Fprint(w, `[{"task":"STREAMING_EXTENSION","operation":"ADD","result":"ERROR","meta":{"error":"boom"}}]`)
+			return
+		}
+		fmt.Fprint(w, `{"totalCount":0}`)
+	})
+
+	err := waitForDocumentIndexed(context.Background(), client, "src", "https://docs/1", time.Now(), time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("expected indexing error mentioning boom, got %v", err)
+	}
+}
+
+func TestWaitForDocumentIndexed_Timeout(t *testing.T) {
+	indexingPollInterval = time.Millisecond
+	t.Cleanup(func() { indexingPollInterval = 5 * time.Second })
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/logs/") {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `{"totalCount":0}`)
+	})
+
+	err := waitForDocumentIndexed(context.Background(), client, "src", "https://docs/1", time.Now(), 20*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "was not indexed within") {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &CoveoSearchTokenEphemeralResource{}
+
+const (
+	defaultSearchTokenUserProvider = "Email Security Provider"
+	defaultSearchTokenUserType     = "User"
+)
+
+// coveoSearchTokenUserID is an identity the search token impersonates.
+type coveoSearchTokenUserID struct {
+	Name     string `json:"name"`
+	Provider string `json:"provider"`
+	Type     string `json:"type"`
+}
+
+// coveoSearchTokenRequest is the body of a search token request.
+type coveoSearchTokenRequest struct {
+	UserIDs   []coveoSearchTokenUserID `json:"userIds"`
+	SearchHub string                   `json:"searchHub,omitempty"`
+	Pipeline  string                   `json:"pipeline,omitempty"`
+	Filter    string                   `json:"filter,omitempty"`
+	ValidFor  int64                    `json:"validFor,omitempty"`
+}
+
+// createSearchToken requests a search token from the Search API.
+func createSearchToken(ctx context.Context, client *CoveoClient, req coveoSearchTokenRequest) (string, error) {
+	body, err := client.DoSearchRequest(ctx, "POST", "token", req)
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", err
+	}
+	if resp.Token == "" {
+		return "", fmt.Errorf("response did not include a token")
+	}
+	return resp.Token, nil
+}
+
+// CoveoSearchTokenEphemeralResource generates a short-lived search token.
+// Being ephemeral, the token is never written to plan or state.
+type CoveoSearchTokenEphemeralResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSearchTokenEphemeralResource(client *CoveoClient) ephemeral.EphemeralResource {
+	return &CoveoSearchTokenEphemeralResource{client: client}
+}
+
+type coveoSearchTokenModel struct {
+	UserIDs   []coveoSearchTokenUserIDModel `tfsdk:"user_ids"`
+	SearchHub types.String                  `tfsdk:"search_hub"`
+	Pipeline  types.String                  `tfsdk:"pipeline"`
+	Filter    types.String                  `tfsdk:"filter"`
+	ValidFor  types.String                  `tfsdk:"valid_for"`
+	Token     types.String                  `tfsdk:"token"`
+}
+
+type coveoSearchTokenUserIDModel struct {
+	Name     types.String `tfsdk:"name"`
+	Provider types.String `tfsdk:"provider"`
+	Type     types.String `tfsdk:"type"`
+}
+
+// Metadata sets the ephemeral resource type name.
+func (r *CoveoSearchTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "coveo_search_token"
+}
+
+// Schema defines the schema for the search token ephemeral resource.
+func (r *CoveoSearchTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates a short-lived Coveo search token. The token is never stored in plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"user_ids": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The identities the token impersonates.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The identity name, such as an email address.",
+						},
+						"provider": schema.StringAttribute{
+							Optional:    true,
+							Description: "The security identity provider. Defaults to Email Security Provider.",
+						},
+						"type": schema.StringAttribute{
+							Optional:    true,
+							Description: "The identity type: User, Group, VirtualGroup or Unknown. Defaults to User.",
+						},
+					},
+				},
+			},
+			"search_hub": schema.StringAttribute{
+				Optional:    true,
+				Description: "The search hub enforced by the token.",
+			},
+			"pipeline": schema.StringAttribute{
+				Optional:    true,
+				Description: "The query pipeline enforced by the token.",
+			},
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "A query expression added to every query made with the token.",
+			},
+			"valid_for": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long the token is valid, as a Go duration such as 2h. Defaults to the Search API default of 24h.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated search token.",
+			},
+		},
+	}
+}
+
+// Open generates the token.
+func (r *CoveoSearchTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var data coveoSearchTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenReq := coveoSearchTokenRequest{
+		UserIDs:   []coveoSearchTokenUserID{},
+		SearchHub: data.SearchHub.ValueString(),
+		Pipeline:  data.Pipeline.ValueString(),
+		Filter:    data.Filter.ValueString(),
+	}
+	for _, u := range data.UserIDs {
+		id := coveoSearchTokenUserID{
+			Name:     u.Name.ValueString(),
+			Provider: defaultSearchTokenUserProvider,
+			Type:     defaultSearchTokenUserType,
+		}
+		if !u.Provider.IsNull() {
+			id.Provider = u.Provider.ValueString()
+		}
+		if !u.Type.IsNull() {
+			id.Type = u.Type.ValueString()
+		}
+		tokenReq.UserIDs = append(tokenReq.UserIDs, id)
+	}
+	if !data.ValidFor.IsNull() {
+		d, err := time.ParseDuration(data.ValidFor.ValueString())
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("valid_for"), "Invalid Configuration", fmt.Sprintf("valid_for must be a positive duration, got %q.", data.ValidFor.ValueString()))
+			return
+		}
+		tokenReq.ValidFor = d.Milliseconds()
+	}
+
+	token, err := createSearchToken(ctx, r.client, tokenReq)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to generate search token: %s", err))
+		return
+	}
+
+	data.Token = types.StringValue(token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
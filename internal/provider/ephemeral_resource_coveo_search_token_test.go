@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestCreateSearchToken(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/search/v2/token" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req coveoSearchTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if len(req.UserIDs) != 1 || req.UserIDs[0].Name != "build@example.com" || req.SearchHub != "docs" || req.ValidFor != 3600000 {
+			t.Errorf("unexpected request body %+v", req)
+		}
+		fmt.Fprint(w, `{"token":"eyJhbGciOi"}`)
+	})
+
+	token, err := createSearchToken(context.Background(), client, coveoSearchTokenRequest{
+		UserIDs:   []coveoSearchTokenUserID{{Name: "build@example.com", Provider: defaultSearchTokenUserProvider, Type: defaultSearchTokenUserType}},
+		SearchHub: "docs",
+		ValidFor:  3600000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "eyJhbGciOi" {
+		t.Errorf("token = %q", token)
+	}
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// ClientOptions customizes the HTTP transport used by CoveoClient.
+type ClientOptions struct {
+	// UserAgent is sent with every request.
+	UserAgent string
+	// HTTPProxy is the URL of the proxy all requests go through. When empty,
+	// the standard HTTP_PROXY/HTTPS_PROXY/NO_PROXY variables are honored.
+	HTTPProxy string
+	// CACertFile is a PEM bundle trusted in addition to the system roots.
+	CACertFile string
+	// ClientCertFile and ClientKeyFile are the PEM certificate and key
+	// presented for mutual TLS.
+	ClientCertFile string
+	ClientKeyFile  string
+	// InsecureSkipVerify disables server certificate verification. Only meant
+	// for local mock servers.
+	InsecureSkipVerify bool
+}
+
+// userAgent returns the User-Agent sent by the provider for the given version.
+func userAgent(version string) string {
+	return fmt.Sprintf("terraform-provider-coveo/%s", version)
+}
+
+// newHTTPTransport builds the base transport from the client options.
+func newHTTPTransport(opts ClientOptions) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if opts.HTTPProxy != "" {
+		proxyURL, err := url.Parse(opts.HTTPProxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid http_proxy %q: %w", opts.HTTPProxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: opts.InsecureSkipVerify, //nolint:gosec // opt-in, for local mocks only
+	}
+
+	if opts.CACertFile != "" {
+		pem, err := os.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading ca_cert_file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca_cert_file %s does not contain any PEM certificate", opts.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opts.ClientCertFile != "" || opts.ClientKeyFile != "" {
+		if opts.ClientCertFile == "" || opts.ClientKeyFile == "" {
+			return nil, fmt.Errorf("client_cert_file and client_key_file must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
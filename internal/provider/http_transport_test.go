@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUserAgent(t *testing.T) {
+	if got, want := userAgent("1.2.3"), "terraform-provider-coveo/1.2.3"; got != want {
+		t.Errorf("userAgent() = %q, want %q", got, want)
+	}
+}
+
+func TestNewHTTPTransport_Proxy(t *testing.T) {
+	transport, err := newHTTPTransport(ClientOptions{HTTPProxy: "http://proxy.internal:3128"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest("GET", "https://platform.cloud.coveo.com", nil)
+	proxyURL, err := transport.Proxy(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proxyURL == nil || proxyURL.Host != "proxy.internal:3128" {
+		t.Errorf("proxy = %v, want proxy.internal:3128", proxyURL)
+	}
+}
+
+func TestNewHTTPTransport_InsecureSkipVerify(t *testing.T) {
+	transport, err := newHTTPTransport(ClientOptions{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("expected InsecureSkipVerify to be set")
+	}
+}
+
+func TestNewHTTPTransport_Errors(t *testing.T) {
+	notPEM := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]ClientOptions{
+		"missing ca file":   {CACertFile: filepath.Join(t.TempDir(), "missing.pem")},
+		"ca file not pem":   {CACertFile: notPEM},
+		"cert without key":  {ClientCertFile: "client.pem"},
+		"key without cert":  {ClientKeyFile: "client.key"},
+		"missing key pair":  {ClientCertFile: "missing.pem", ClientKeyFile: "missing.key"},
+		"invalid proxy url": {HTTPProxy: "://bad"},
+	}
+	for name, opts := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newHTTPTransport(opts); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
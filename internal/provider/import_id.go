@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// splitImportID splits an import ID into one part per name, separated by
+// slashes, such as group_id/username. The last part keeps any further
+// slashes, and no part may be empty.
+func splitImportID(id string, names ...string) ([]string, error) {
+	parts := strings.SplitN(id, "/", len(names))
+	if len(parts) != len(names) {
+		return nil, fmt.Errorf("expected %s, got %q", strings.Join(names, "/"), id)
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("expected %s, got %q", strings.Join(names, "/"), id)
+		}
+	}
+	return parts, nil
+}
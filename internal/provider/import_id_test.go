@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSplitImportID(t *testing.T) {
+	parts, err := splitImportID("grp/jane@example.com", "group_id", "username")
+	if err != nil || fmt.Sprint(parts) != "[grp jane@example.com]" {
+		t.Errorf("splitImportID() = %q, %v", parts, err)
+	}
+	parts, err = splitImportID("src/PRE_CONVERSION/ext", "source_id", "stage", "extension_id")
+	if err != nil || fmt.Sprint(parts) != "[src PRE_CONVERSION ext]" {
+		t.Errorf("splitImportID() = %q, %v", parts, err)
+	}
+	for _, id := range []string{"grp", "/jane", "grp/", ""} {
+		if _, err := splitImportID(id, "group_id", "username"); err == nil {
+			t.Errorf("splitImportID(%q) succeeded, want an error", id)
+		}
+	}
+	if _, err := splitImportID("src//ext", "source_id", "stage", "extension_id"); err == nil {
+		t.Error("splitImportID() accepted an empty stage")
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redactedValue replaces secrets in logged headers and bodies.
+const redactedValue = "***"
+
+// sensitiveHeaders lists the request and response headers whose values are
+// never logged.
+var sensitiveHeaders = map[string]bool{
+	"authorization": true,
+	"cookie":        true,
+	"set-cookie":    true,
+}
+
+// sensitiveBodyKeys lists the JSON keys whose values are redacted from logged
+// bodies. Keys are compared case-insensitively.
+var sensitiveBodyKeys = map[string]bool{
+	"apikey":       true,
+	"api_key":      true,
+	"token":        true,
+	"accesstoken":  true,
+	"refreshtoken": true,
+	"password":     true,
+	"secret":       true,
+	"clientsecret": true,
+	"privatekey":   true,
+}
+
+// apiKeyBodyKeys lists the additional keys redacted from API key requests and
+// responses, where "value" holds the key itself. Elsewhere it holds ordinary
+// data such as permission filter values.
+var apiKeyBodyKeys = map[string]bool{
+	"value": true,
+}
+
+// isAPIKeyRequest reports whether req targets the API keys of the
+// organization.
+func isAPIKeyRequest(req *http.Request) bool {
+	for _, segment := range strings.Split(req.URL.Path, "/") {
+		if segment == "apikeys" {
+			return true
+		}
+	}
+	return false
+}
+
+// traceEnabled reports whether provider logs are written at TRACE level,
+// following the precedence of the Terraform log environment variables.
+func traceEnabled() bool {
+	for _, name := range []string{"TF_LOG_PROVIDER_COVEO", "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := os.Getenv(name); level != "" {
+			return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+		}
+	}
+	return false
+}
+
+// loggingTransport is an http.RoundTripper that logs every Coveo API call
+// through terraform-plugin-log. Method, URL, status and latency are logged at
+// DEBUG, headers and bodies at TRACE. Output is controlled with
+// TF_LOG_PROVIDER_COVEO. Bodies are only buffered when trace is set.
+type loggingTransport struct {
+	next    http.RoundTripper
+	secrets []string
+	trace   bool
+}
+
+func newLoggingTransport(next http.RoundTripper, secrets ...string) *loggingTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &loggingTransport{next: next, secrets: secrets, trace: traceEnabled()}
+}
+
+// RoundTrip logs the request, forwards it and logs the response.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := t.maskSecrets(req.Context())
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+	}
+
+	tflog.Debug(ctx, "Sending Coveo API request", fields)
+	apiKey := isAPIKeyRequest(req)
+	if t.trace {
+		reqBody, err := peekRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Trace(ctx, "Coveo API request details", map[string]interface{}{
+			"http_method":      req.Method,
+			"http_url":         req.URL.String(),
+			"http_req_headers": redactHeaders(req.Header),
+			"http_req_body":    redactBody(reqBody, apiKey),
+		})
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Coveo API request failed", fields)
+		return nil, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	tflog.Debug(ctx, "Received Coveo API response", fields)
+	if !t.trace {
+		return resp, nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	tflog.Trace(ctx, "Coveo API response details", map[string]interface{}{
+		"http_url":          req.URL.String(),
+		"http_status":       resp.StatusCode,
+		"http_resp_headers": redactHeaders(resp.Header),
+		"http_resp_body":    redactBody(respBody, apiKey),
+	})
+
+	return resp, nil
+}
+
+// maskSecrets makes sure the configured credentials never show up verbatim in
+// log messages or field values, whatever field they end up in.
+func (t *loggingTransport) maskSecrets(ctx context.Context) context.Context {
+	var secrets []string
+	for _, s := range t.secrets {
+		if s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+	if len(secrets) == 0 {
+		return ctx
+	}
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, secrets...)
+	return tflog.MaskMessageStrings(ctx, secrets...)
+}
+
+// peekRequestBody returns a copy of the request body without consuming it.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// redactHeaders flattens the headers for logging, hiding sensitive values.
+func redactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for k, v := range h {
+		if sensitiveHeaders[strings.ToLower(k)] {
+			out[k] = redactedValue
+			continue
+		}
+		out[k] = strings.Join(v, ", ")
+	}
+	return out
+}
+
+// redactBody returns the body as a string with sensitive JSON values hidden,
+// including the key values of API key bodies when apiKey is set. Bodies that
+// are not JSON are logged as-is.
+func redactBody(body []byte, apiKey bool) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	redacted, err := json.Marshal(redactJSON(v, apiKey))
+	if err != nil {
+		return string(body)
+	}
+	return string(redacted)
+}
+
+func redactJSON(v interface{}, apiKey bool) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, inner := range val {
+			if key := strings.ToLower(k); sensitiveBodyKeys[key] || (apiKey && apiKeyBodyKeys[key]) {
+				val[k] = redactedValue
+				continue
+			}
+			val[k] = redactJSON(inner, apiKey)
+		}
+		return val
+	case []interface{}:
+		for i, inner := range val {
+			val[i] = redactJSON(inner, apiKey)
+		}
+		return val
+	default:
+		return v
+	}
+}
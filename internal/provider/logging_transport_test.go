@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("Authorization", "Bearer xx-secret")
+	h.Set("Content-Type", "application/json")
+
+	got := redactHeaders(h)
+	if got["Authorization"] != redactedValue {
+		t.Errorf("Authorization = %q, want %q", got["Authorization"], redactedValue)
+	}
+	if got["Content-Type"] != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got["Content-Type"])
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]struct {
+		body   string
+		apiKey bool
+		want   string
+	}{
+		"empty":    {body: "", want: ""},
+		"not json": {body: "plain text", want: "plain text"},
+		"api key": {
+			body:   `{"id":"key1","value":"xx-secret"}`,
+			apiKey: true,
+			want:   `{"id":"key1","value":"***"}`,
+		},
+		"filter value": {
+			body: `{"filters":[{"value":"sales"}]}`,
+			want: `{"filters":[{"value":"sales"}]}`,
+		},
+		"nested": {
+			body: `{"items":[{"Password":"p","name":"n"}]}`,
+			want: `{"items":[{"Password":"***","name":"n"}]}`,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := redactBody([]byte(tc.body), tc.apiKey); got != tc.want {
+				t.Errorf("redactBody() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsAPIKeyRequest(t *testing.T) {
+	for path, want := range map[string]bool{
+		"/rest/organizations/myorg/apikeys":      true,
+		"/rest/organizations/myorg/apikeys/key1": true,
+		"/rest/organizations/myorg/groups":       false,
+		"/rest/organizations/myorg/apikeysets":   false,
+	} {
+		req := httptest.NewRequest("GET", path, nil)
+		if got := isAPIKeyRequest(req); got != want {
+			t.Errorf("isAPIKeyRequest(%s) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestTraceEnabled(t *testing.T) {
+	cases := map[string]struct {
+		provider, all string
+		want          bool
+	}{
+		"unset":             {want: false},
+		"trace":             {all: "trace", want: true},
+		"json":              {all: "JSON", want: true},
+		"debug":             {all: "DEBUG", want: false},
+		"provider override": {provider: "DEBUG", all: "TRACE", want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TF_LOG_PROVIDER", "")
+			t.Setenv("TF_LOG_PROVIDER_COVEO", tc.provider)
+			t.Setenv("TF_LOG", tc.all)
+			if got := traceEnabled(); got != tc.want {
+				t.Errorf("traceEnabled() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLoggingTransport_PreservesBodies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"title":"t"}` {
+			t.Errorf("server received body %q", body)
+		}
+		w.Write([]byte(`{"value":"xx-secret"}`))
+	}))
+	defer server.Close()
+
+	transport := newLoggingTransport(nil, "xx-secret")
+	transport.trace = true
+	client := &http.Client{Transport: transport}
+	req, err := http.NewRequestWithContext(context.Background(), "POST", server.URL, strings.NewReader(`{"title":"t"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"value":"xx-secret"}` {
+		t.Errorf("client received body %q", body)
+	}
+}
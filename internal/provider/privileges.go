@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+// coveoPrivilege is a privilege granted to a group or an API key.
+type coveoPrivilege = coveo.Privilege
+
+type coveoPrivilegeModel struct {
+	Owner        types.String `tfsdk:"owner"`
+	TargetDomain types.String `tfsdk:"target_domain"`
+	TargetID     types.String `tfsdk:"target_id"`
+	Type         types.String `tfsdk:"type"`
+}
+
+// privilegeAllTargets is the target ID granting a privilege on every target
+// of its domain.
+const privilegeAllTargets = "*"
+
+var privilegeAttrTypes = map[string]attr.Type{
+	"owner":         types.StringType,
+	"target_domain": types.StringType,
+	"target_id":     types.StringType,
+	"type":          types.StringType,
+}
+
+// privilegeBlock is the schema of the repeated privilege block shared by the
+// resources that grant privileges.
+func privilegeBlock() schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		Description: "A privilege granted, such as owner PLATFORM, target_domain SOURCE and type EDIT.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"owner": schema.StringAttribute{
+					Required:    true,
+					Description: "The service owning the privilege, such as PLATFORM, SEARCH_API or USAGE_ANALYTICS.",
+				},
+				"target_domain": schema.StringAttribute{
+					Required:    true,
+					Description: "The domain of the privilege, such as SOURCE, FIELD or IMPERSONATE.",
+				},
+				"target_id": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(privilegeAllTargets),
+					Description: "The ID of the target the privilege applies to. Defaults to * for all targets.",
+				},
+				"type": schema.StringAttribute{
+					Optional:    true,
+					Description: "The access level, such as VIEW or EDIT. Unset for privileges that have no level.",
+				},
+			},
+		},
+	}
+}
+
+// expandPrivileges converts a set of privilege blocks to the API model.
+func expandPrivileges(ctx context.Context, set types.Set) ([]coveoPrivilege, error) {
+	privileges := []coveoPrivilege{}
+	if set.IsNull() || set.IsUnknown() {
+		return privileges, nil
+	}
+	var models []coveoPrivilegeModel
+	if diags := set.ElementsAs(ctx, &models, false); diags.HasError() {
+		return nil, fmt.Errorf("invalid privilege blocks")
+	}
+	for _, m := range models {
+		privileges = append(privileges, coveoPrivilege{
+			Owner:        m.Owner.ValueString(),
+			TargetDomain: m.TargetDomain.ValueString(),
+			TargetID:     m.TargetID.ValueString(),
+			Type:         m.Type.ValueString(),
+		})
+	}
+	return privileges, nil
+}
+
+// flattenPrivileges converts API privileges to a set of privilege blocks.
+func flattenPrivileges(ctx context.Context, privileges []coveoPrivilege) (types.Set, error) {
+	models := make([]coveoPrivilegeModel, 0, len(privileges))
+	for _, p := range privileges {
+		m := coveoPrivilegeModel{
+			Owner:        types.StringValue(p.Owner),
+			TargetDomain: types.StringValue(p.TargetDomain),
+			TargetID:     types.StringValue(p.TargetID),
+			Type:         types.StringNull(),
+		}
+		if p.TargetID == "" {
+			m.TargetID = types.StringValue(privilegeAllTargets)
+		}
+		if p.Type != "" {
+			m.Type = types.StringValue(p.Type)
+		}
+		models = append(models, m)
+	}
+	set, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: privilegeAttrTypes}, models)
+	if diags.HasError() {
+		return set, fmt.Errorf("invalid privileges in response")
+	}
+	return set, nil
+}
+
+// listPrivileges returns the privileges that can be granted in the
+// organization.
+func listPrivileges(ctx context.Context, client *CoveoClient) ([]coveoPrivilege, error) {
+	body, err := client.DoPlatformRequest(ctx, "GET", "privileges", nil)
+	if err != nil {
+		return nil, err
+	}
+	var privileges []coveoPrivilege
+	if err := json.Unmarshal(body, &privileges); err != nil {
+		return nil, err
+	}
+	return privileges, nil
+}
+
+// validatePrivileges checks that every privilege exists in catalog, comparing
+// owner and target domain, and the type when the catalog entry has one.
+func validatePrivileges(privileges, catalog []coveoPrivilege) error {
+	levels := map[string]map[string]bool{}
+	for _, c := range catalog {
+		key := c.Owner + ":" + c.TargetDomain
+		if levels[key] == nil {
+			levels[key] = map[string]bool{}
+		}
+		if c.Type != "" {
+			levels[key][c.Type] = true
+		}
+	}
+
+	var invalid []string
+	for _, p := range privileges {
+		key := p.Owner + ":" + p.TargetDomain
+		allowed, ok := levels[key]
+		switch {
+		case !ok:
+			invalid = append(invalid, key)
+		case p.Type != "" && len(allowed) > 0 && !allowed[p.Type]:
+			invalid = append(invalid, key+":"+p.Type)
+		}
+	}
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("unknown privileges: %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestValidatePrivileges(t *testing.T) {
+	catalog := []coveoPrivilege{
+		{Owner: "PLATFORM", TargetDomain: "SOURCE", Type: "VIEW"},
+		{Owner: "PLATFORM", TargetDomain: "SOURCE", Type: "EDIT"},
+		{Owner: "SEARCH_API", TargetDomain: "IMPERSONATE"},
+	}
+
+	valid := []coveoPrivilege{
+		{Owner: "PLATFORM", TargetDomain: "SOURCE", Type: "EDIT", TargetID: "*"},
+		{Owner: "SEARCH_API", TargetDomain: "IMPERSONATE"},
+	}
+	if err := validatePrivileges(valid, catalog); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	invalid := []coveoPrivilege{
+		{Owner: "PLATFORM", TargetDomain: "SOURCE", Type: "DELETE"},
+		{Owner: "PLATFORM", TargetDomain: "SOURCES"},
+	}
+	err := validatePrivileges(invalid, catalog)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, want := range []string{"PLATFORM:SOURCE:DELETE", "PLATFORM:SOURCES"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %s", err, want)
+		}
+	}
+}
+
+func TestPrivilegesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	set, err := flattenPrivileges(ctx, []coveoPrivilege{
+		{Owner: "PLATFORM", TargetDomain: "FIELD", Type: "VIEW"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	privileges, err := expandPrivileges(ctx, set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := coveoPrivilege{Owner: "PLATFORM", TargetDomain: "FIELD", Type: "VIEW", TargetID: privilegeAllTargets}
+	if len(privileges) != 1 || privileges[0] != want {
+		t.Errorf("got %+v, want %+v", privileges, want)
+	}
+}
@@ -1,22 +1,25 @@
 package provider
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"net/http"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
     _ provider.Provider = &coveoProvider{}
+    _ provider.ProviderWithEphemeralResources = &coveoProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -52,63 +55,124 @@ func (p *coveoProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
                 Required:    true,
                 Description: "The Coveo organization ID.",
             },
+            "http_proxy": schema.StringAttribute{
+                Optional:    true,
+                Description: "URL of the proxy used for all Coveo API calls. Defaults to the HTTP_PROXY/HTTPS_PROXY environment variables.",
+            },
+            "ca_cert_file": schema.StringAttribute{
+                Optional:    true,
+                Description: "Path to a PEM bundle of additional certificate authorities to trust.",
+            },
+            "client_cert_file": schema.StringAttribute{
+                Optional:    true,
+                Description: "Path to a PEM client certificate for mutual TLS. Requires client_key_file.",
+            },
+            "client_key_file": schema.StringAttribute{
+                Optional:    true,
+                Sensitive:   true,
+                Description: "Path to the PEM private key matching client_cert_file.",
+            },
+            "insecure_skip_verify": schema.BoolAttribute{
+                Optional:    true,
+                Description: "Skip TLS certificate verification. Only use this against local mock servers.",
+            },
         },
     }
 }
 
-// CoveoClient is a simple client to interact with the Coveo API.
+// CoveoClient is the Coveo API client shared by data sources and resources.
+// It embeds the typed SDK client and adds the raw request helpers for the API
+// families the SDK does not cover yet.
 type CoveoClient struct {
-    ApiKey         string
-    OrganizationID string
-    HttpClient     *http.Client
+    *coveo.Client
 }
 
-func NewCoveoClient(apiKey, organizationID string) *CoveoClient {
-    return &CoveoClient{
-        ApiKey:         apiKey,
-        OrganizationID: organizationID,
-        HttpClient:     &http.Client{},
-    }
-}
-// DoRequest is a helper to make API requests and parse the response.
-func (c *CoveoClient) DoRequest(method, endpoint string, body interface{}) ([]byte, error) {
-    // Include the organization ID in the base URL
-    baseUrl := fmt.Sprintf("https://api.cloud.coveo.com/push/v1/organizations/%s", c.OrganizationID)
-    url := fmt.Sprintf("%s/%s", baseUrl, endpoint)
-
-    var reqBody []byte
-    var err error
-    if body != nil {
-        reqBody, err = json.Marshal(body)
-        if err != nil {
-            return nil, err
-        }
-    }
-    req, err := http.NewRequest(method, url, bytes.NewBuffer(reqBody))
+func NewCoveoClient(apiKey, organizationID string, opts ClientOptions) (*CoveoClient, error) {
+    transport, err := newHTTPTransport(opts)
     if err != nil {
         return nil, err
     }
-    req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.ApiKey))
-    req.Header.Set("Content-Type", "application/json")
+    client := coveo.NewClient(apiKey, organizationID, &http.Client{
+        Transport: newLoggingTransport(transport, apiKey),
+    })
+    client.UserAgent = opts.UserAgent
+    return &CoveoClient{Client: client}, nil
+}
 
-    resp, err := c.HttpClient.Do(req)
-    if err != nil {
-        return nil, err
+// APIError is returned when the Coveo API answers with an error status.
+type APIError = coveo.APIError
+
+// isNotFound reports whether err is a 404 returned by the Coveo API.
+func isNotFound(err error) bool {
+    return coveo.IsNotFound(err)
+}
+
+// DoRequest is a helper to make Push API requests and parse the response.
+func (c *CoveoClient) DoRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+    return c.DoURLRequest(ctx, method, c.PushEndpointURL(endpoint, nil), body)
+}
+
+// DoPlatformRequest makes a request against the organization on the Platform
+// API. An empty endpoint targets the organization itself.
+func (c *CoveoClient) DoPlatformRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+    return c.DoURLRequest(ctx, method, c.PlatformEndpointURL(endpoint, nil), body)
+}
+
+// DoSearchAdminRequest makes a request against the Search API administration
+// endpoints (query pipelines and their components), which take the
+// organization as a query parameter rather than in the path.
+func (c *CoveoClient) DoSearchAdminRequest(ctx context.Context, method, endpoint string, query url.Values, body interface{}) ([]byte, error) {
+    return c.DoURLRequest(ctx, method, c.SearchAdminEndpointURL(endpoint, query), body)
+}
+
+// DoLogsRequest queries the Logs API for the organization.
+func (c *CoveoClient) DoLogsRequest(ctx context.Context, query url.Values, body interface{}) ([]byte, error) {
+    u := fmt.Sprintf("%s/logs/v1/organizations/%s", c.APIURL, c.OrganizationID)
+    if len(query) > 0 {
+        u = fmt.Sprintf("%s?%s", u, query.Encode())
     }
-    defer resp.Body.Close()
+    return c.DoURLRequest(ctx, "POST", u, body)
+}
 
-    if resp.StatusCode >= 400 {
-        return nil, fmt.Errorf("API request error: %s", resp.Status)
+// DoUARequest makes a request against the Usage Analytics API, which takes the
+// organization as a query parameter.
+func (c *CoveoClient) DoUARequest(ctx context.Context, method, endpoint string, query url.Values, body interface{}) ([]byte, error) {
+    if query == nil {
+        query = url.Values{}
     }
-    return ioutil.ReadAll(resp.Body)
+    query.Set("org", c.OrganizationID)
+    u := fmt.Sprintf("%s/rest/ua/v15/%s?%s", c.PlatformURL, endpoint, query.Encode())
+    return c.DoURLRequest(ctx, method, u, body)
+}
+
+// DoSearchRequest makes a request against the Search API. An empty endpoint
+// targets the query endpoint itself.
+func (c *CoveoClient) DoSearchRequest(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+    u := fmt.Sprintf("%s/rest/search/v2", c.PlatformURL)
+    if endpoint != "" {
+        u = fmt.Sprintf("%s/%s", u, endpoint)
+    }
+    u = fmt.Sprintf("%s?organizationId=%s", u, url.QueryEscape(c.OrganizationID))
+    return c.DoURLRequest(ctx, method, u, body)
+}
+
+// DoURLRequest sends an authenticated request to an absolute URL and returns
+// the raw response body.
+func (c *CoveoClient) DoURLRequest(ctx context.Context, method, url string, body interface{}) ([]byte, error) {
+    return c.DoRaw(ctx, method, url, body)
 }
 
 // Configure prepares a Coveo API client for data sources and resources.
 func (p *coveoProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
     // Retrieve provider configuration values.
     var config struct {
-        ApiKey         string `tfsdk:"api_key"`
-        OrganizationID string `tfsdk:"organization_id"`
+        ApiKey             string       `tfsdk:"api_key"`
+        OrganizationID     string       `tfsdk:"organization_id"`
+        HTTPProxy          types.String `tfsdk:"http_proxy"`
+        CACertFile         types.String `tfsdk:"ca_cert_file"`
+        ClientCertFile     types.String `tfsdk:"client_cert_file"`
+        ClientKeyFile      types.String `tfsdk:"client_key_file"`
+        InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
     }
 
 
@@ -132,19 +196,74 @@ func (p *coveoProvider) Configure(ctx context.Context, req provider.ConfigureReq
     //     resp.Diagnostics.AddError("Client Initialization Error", "Failed to initialize Coveo client.")
     //     return
     // }
-    p.client =  NewCoveoClient(config.ApiKey, config.OrganizationID)
+    client, err := NewCoveoClient(config.ApiKey, config.OrganizationID, ClientOptions{
+        UserAgent:          userAgent(p.version),
+        HTTPProxy:          config.HTTPProxy.ValueString(),
+        CACertFile:         config.CACertFile.ValueString(),
+        ClientCertFile:     config.ClientCertFile.ValueString(),
+        ClientKeyFile:      config.ClientKeyFile.ValueString(),
+        InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+    })
+    if err != nil {
+        resp.Diagnostics.AddError("Client Initialization Error", fmt.Sprintf("Failed to initialize Coveo client: %s", err))
+        return
+    }
+    p.client = client
     // Pass the client to resources
     // resp.ResourceData = client
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *coveoProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-    return nil
+    return []func() datasource.DataSource{
+        func() datasource.DataSource { return NewCoveoOrganizationDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoSourceDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoSourcesDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoFieldDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoFieldsDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoQueryPipelineDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoIndexDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoSearchDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoExtensionTestDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoUAStatisticsDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoActivitiesDataSource(p.client) },
+        func() datasource.DataSource { return NewCoveoSourceLogsDataSource(p.client) },
+    }
 }
 
 func (p *coveoProvider) Resources(_ context.Context) []func() resource.Resource {
     return []func() resource.Resource{
         func() resource.Resource { return NewCoveoIndexResource(p.client) },
         func() resource.Resource { return NewCoveoDocumentResource(p.client) },
+        func() resource.Resource { return NewCoveoOrganizationSettingsResource(p.client) },
+        func() resource.Resource { return NewCoveoExtensionResource(p.client) },
+        func() resource.Resource { return NewCoveoSourceExtensionResource(p.client) },
+        func() resource.Resource { return NewCoveoCatalogConfigurationResource(p.client) },
+        func() resource.Resource { return NewCoveoCatalogResource(p.client) },
+        func() resource.Resource { return NewCoveoMLModelResource(p.client) },
+        func() resource.Resource { return NewCoveoMLModelAssociationResource(p.client) },
+        func() resource.Resource { return NewCoveoUADimensionResource(p.client) },
+        func() resource.Resource { return NewCoveoUAReportResource(p.client) },
+        func() resource.Resource { return NewCoveoUAPermissionFilterResource(p.client) },
+        func() resource.Resource { return NewCoveoSearchHubResource(p.client) },
+        func() resource.Resource { return NewCoveoSearchPageResource(p.client) },
+        func() resource.Resource { return NewCoveoGroupResource(p.client) },
+        func() resource.Resource { return NewCoveoGroupMemberResource(p.client) },
+        func() resource.Resource { return NewCoveoGroupInviteResource(p.client) },
+        func() resource.Resource { return NewCoveoNotificationSubscriptionResource(p.client) },
+        func() resource.Resource { return NewCoveoIndexBackupResource(p.client) },
+        func() resource.Resource { return NewCoveoIndexRestoreResource(p.client) },
+        func() resource.Resource { return NewCoveoSourceRefreshResource(p.client) },
+        func() resource.Resource { return NewCoveoSourceScheduleResource(p.client) },
+        func() resource.Resource { return NewCoveoCrawlingModuleResource(p.client) },
+        func() resource.Resource { return NewCoveoSourceCredentialsResource(p.client) },
+    }
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the
+// provider. Their values are never persisted to plan or state.
+func (p *coveoProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+    return []func() ephemeral.EphemeralResource{
+        func() ephemeral.EphemeralResource { return NewCoveoSearchTokenEphemeralResource(p.client) },
     }
 }
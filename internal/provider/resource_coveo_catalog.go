@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoCatalogResource{}
+
+// coveoCatalog is the commerce catalog model of the Platform API.
+type coveoCatalog struct {
+	ID                     string `json:"id,omitempty"`
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	SourceID               string `json:"sourceId"`
+	AvailabilitySourceID   string `json:"availabilitySourceId,omitempty"`
+	CatalogConfigurationID string `json:"catalogConfigurationId"`
+}
+
+// CoveoCatalogResource manages a commerce catalog, which binds product and
+// availability sources to a catalog configuration.
+type CoveoCatalogResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoCatalogResource(client *CoveoClient) resource.Resource {
+	return &CoveoCatalogResource{client: client}
+}
+
+type coveoCatalogModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	ProductSourceID        types.String `tfsdk:"product_source_id"`
+	AvailabilitySourceID   types.String `tfsdk:"availability_source_id"`
+	CatalogConfigurationID types.String `tfsdk:"catalog_configuration_id"`
+}
+
+func (m *coveoCatalogModel) toAPI() coveoCatalog {
+	return coveoCatalog{
+		ID:                     m.ID.ValueString(),
+		Name:                   m.Name.ValueString(),
+		Description:            m.Description.ValueString(),
+		SourceID:               m.ProductSourceID.ValueString(),
+		AvailabilitySourceID:   m.AvailabilitySourceID.ValueString(),
+		CatalogConfigurationID: m.CatalogConfigurationID.ValueString(),
+	}
+}
+
+func (m *coveoCatalogModel) fromAPI(c *coveoCatalog) {
+	m.ID = types.StringValue(c.ID)
+	m.Name = types.StringValue(c.Name)
+	m.Description = types.StringValue(c.Description)
+	m.ProductSourceID = types.StringValue(c.SourceID)
+	m.AvailabilitySourceID = types.StringNull()
+	if c.AvailabilitySourceID != "" {
+		m.AvailabilitySourceID = types.StringValue(c.AvailabilitySourceID)
+	}
+	m.CatalogConfigurationID = types.StringValue(c.CatalogConfigurationID)
+}
+
+// read refreshes m from the catalog m.ID.
+func (r *CoveoCatalogResource) read(ctx context.Context, m *coveoCatalogModel) error {
+	body, err := r.client.DoPlatformRequest(ctx, "GET", "catalogs/"+url.PathEscape(m.ID.ValueString()), nil)
+	if err != nil {
+		return err
+	}
+	var catalog coveoCatalog
+	if err := json.Unmarshal(body, &catalog); err != nil {
+		return fmt.Errorf("could not parse response from Coveo API: %w", err)
+	}
+	m.fromAPI(&catalog)
+	return nil
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoCatalogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_catalog"
+}
+
+// Schema defines the schema for the catalog resource.
+func (r *CoveoCatalogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo commerce catalog.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The catalog ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The catalog name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The catalog description.",
+			},
+			"product_source_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the source indexing the products and variants.",
+			},
+			"availability_source_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the source indexing the availabilities.",
+			},
+			"catalog_configuration_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the coveo_catalog_configuration describing the catalog objects.",
+			},
+		},
+	}
+}
+
+// Create creates the catalog.
+func (r *CoveoCatalogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoCatalogModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.DoPlatformRequest(ctx, "POST", "catalogs", plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create catalog: %s", err))
+		return
+	}
+	var created coveoCatalog
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid catalog ID.")
+		return
+	}
+
+	plan.fromAPI(&created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the catalog.
+func (r *CoveoCatalogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoCatalogModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read catalog: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the catalog, then reads it back since the response may
+// have no body.
+func (r *CoveoCatalogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoCatalogModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.DoPlatformRequest(ctx, "PUT", "catalogs/"+url.PathEscape(plan.ID.ValueString()), plan.toAPI()); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update catalog: %s", err))
+		return
+	}
+	if err := r.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read catalog: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the catalog.
+func (r *CoveoCatalogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoCatalogModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", "catalogs/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete catalog: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a catalog by ID.
+func (r *CoveoCatalogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
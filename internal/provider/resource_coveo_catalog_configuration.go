@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoCatalogConfigurationResource{}
+
+// coveoCatalogEntity describes how one kind of commerce object (products,
+// variants or availabilities) is recognized in the index.
+type coveoCatalogEntity struct {
+	IDField            string `json:"idField"`
+	ObjectType         string `json:"objectType"`
+	AvailableSkusField string `json:"availableSkusField,omitempty"`
+}
+
+// coveoCatalogConfiguration is the catalog configuration model of the
+// Platform API.
+type coveoCatalogConfiguration struct {
+	ID           string              `json:"id,omitempty"`
+	Name         string              `json:"name"`
+	Product      coveoCatalogEntity  `json:"product"`
+	Variant      *coveoCatalogEntity `json:"variant,omitempty"`
+	Availability *coveoCatalogEntity `json:"availability,omitempty"`
+}
+
+// CoveoCatalogConfigurationResource manages a commerce catalog configuration.
+type CoveoCatalogConfigurationResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoCatalogConfigurationResource(client *CoveoClient) resource.Resource {
+	return &CoveoCatalogConfigurationResource{client: client}
+}
+
+type coveoCatalogConfigurationModel struct {
+	ID           types.String                   `tfsdk:"id"`
+	Name         types.String                   `tfsdk:"name"`
+	Product      coveoCatalogEntityModel        `tfsdk:"product"`
+	Variant      *coveoCatalogEntityModel       `tfsdk:"variant"`
+	Availability *coveoCatalogAvailabilityModel `tfsdk:"availability"`
+}
+
+type coveoCatalogEntityModel struct {
+	IDField    types.String `tfsdk:"id_field"`
+	ObjectType types.String `tfsdk:"object_type"`
+}
+
+type coveoCatalogAvailabilityModel struct {
+	IDField            types.String `tfsdk:"id_field"`
+	ObjectType         types.String `tfsdk:"object_type"`
+	AvailableSkusField types.String `tfsdk:"available_skus_field"`
+}
+
+// catalogEntityAttributes returns the schema of a catalog entity.
+func catalogEntityAttributes(objectType string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id_field": schema.StringAttribute{
+			Required:    true,
+			Description: "The field holding the unique ID of each object, such as ec_product_id.",
+		},
+		"object_type": schema.StringAttribute{
+			Required:    true,
+			Description: fmt.Sprintf("The value of the objecttype field identifying these objects, such as %s.", objectType),
+		},
+	}
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoCatalogConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_catalog_configuration"
+}
+
+// Schema defines the schema for the catalog configuration resource.
+func (r *CoveoCatalogConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo commerce catalog configuration, which tells how products, variants and availabilities are identified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The catalog configuration ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The catalog configuration name.",
+			},
+			"product": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "How products are identified.",
+				Attributes:  catalogEntityAttributes("Product"),
+			},
+			"variant": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "How product variants are identified.",
+				Attributes:  catalogEntityAttributes("Variant"),
+			},
+			"availability": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "How availabilities (stores, warehouses) are identified.",
+				Attributes: map[string]schema.Attribute{
+					"id_field": schema.StringAttribute{
+						Required:    true,
+						Description: "The field holding the unique ID of each availability.",
+					},
+					"object_type": schema.StringAttribute{
+						Required:    true,
+						Description: "The value of the objecttype field identifying availabilities, such as Availability.",
+					},
+					"available_skus_field": schema.StringAttribute{
+						Required:    true,
+						Description: "The field listing the SKUs an availability applies to.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (m *coveoCatalogConfigurationModel) toAPI() coveoCatalogConfiguration {
+	config := coveoCatalogConfiguration{
+		Name: m.Name.ValueString(),
+		Product: coveoCatalogEntity{
+			IDField:    m.Product.IDField.ValueString(),
+			ObjectType: m.Product.ObjectType.ValueString(),
+		},
+	}
+	if m.Variant != nil {
+		config.Variant = &coveoCatalogEntity{
+			IDField:    m.Variant.IDField.ValueString(),
+			ObjectType: m.Variant.ObjectType.ValueString(),
+		}
+	}
+	if m.Availability != nil {
+		config.Availability = &coveoCatalogEntity{
+			IDField:            m.Availability.IDField.ValueString(),
+			ObjectType:         m.Availability.ObjectType.ValueString(),
+			AvailableSkusField: m.Availability.AvailableSkusField.ValueString(),
+		}
+	}
+	return config
+}
+
+func (m *coveoCatalogConfigurationModel) fromAPI(c *coveoCatalogConfiguration) {
+	m.ID = types.StringValue(c.ID)
+	m.Name = types.StringValue(c.Name)
+	m.Product = coveoCatalogEntityModel{
+		IDField:    types.StringValue(c.Product.IDField),
+		ObjectType: types.StringValue(c.Product.ObjectType),
+	}
+	m.Variant = nil
+	if c.Variant != nil {
+		m.Variant = &coveoCatalogEntityModel{
+			IDField:    types.StringValue(c.Variant.IDField),
+			ObjectType: types.StringValue(c.Variant.ObjectType),
+		}
+	}
+	m.Availability = nil
+	if c.Availability != nil {
+		m.Availability = &coveoCatalogAvailabilityModel{
+			IDField:            types.StringValue(c.Availability.IDField),
+			ObjectType:         types.StringValue(c.Availability.ObjectType),
+			AvailableSkusField: types.StringValue(c.Availability.AvailableSkusField),
+		}
+	}
+}
+
+// Create creates the catalog configuration.
+func (r *CoveoCatalogConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoCatalogConfigurationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.DoPlatformRequest(ctx, "POST", "catalogconfigurations", plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create catalog configuration: %s", err))
+		return
+	}
+	var created coveoCatalogConfiguration
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid catalog configuration ID.")
+		return
+	}
+
+	plan.fromAPI(&created)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the catalog configuration.
+func (r *CoveoCatalogConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoCatalogConfigurationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.DoPlatformRequest(ctx, "GET", "catalogconfigurations/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read catalog configuration: %s", err))
+		return
+	}
+	var config coveoCatalogConfiguration
+	if err := json.Unmarshal(body, &config); err != nil {
+		resp.Diagnostics.AddError("Parse Error", "Could not parse response from Coveo API.")
+		return
+	}
+
+	state.fromAPI(&config)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the catalog configuration.
+func (r *CoveoCatalogConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoCatalogConfigurationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := plan.toAPI()
+	config.ID = plan.ID.ValueString()
+	body, err := r.client.DoPlatformRequest(ctx, "PUT", "catalogconfigurations/"+url.PathEscape(config.ID), config)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update catalog configuration: %s", err))
+		return
+	}
+	var updated coveoCatalogConfiguration
+	if err := json.Unmarshal(body, &updated); err != nil {
+		resp.Diagnostics.AddError("Parse Error", "Could not parse response from Coveo API.")
+		return
+	}
+
+	plan.fromAPI(&updated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the catalog configuration.
+func (r *CoveoCatalogConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoCatalogConfigurationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", "catalogconfigurations/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete catalog configuration: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a catalog configuration by ID.
+func (r *CoveoCatalogConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
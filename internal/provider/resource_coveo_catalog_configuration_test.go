@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCoveoCatalogConfigurationModel_RoundTrip(t *testing.T) {
+	in := coveoCatalogConfigurationModel{
+		Name: types.StringValue("store"),
+		Product: coveoCatalogEntityModel{
+			IDField:    types.StringValue("ec_product_id"),
+			ObjectType: types.StringValue("Product"),
+		},
+		Availability: &coveoCatalogAvailabilityModel{
+			IDField:            types.StringValue("ec_availability_id"),
+			ObjectType:         types.StringValue("Availability"),
+			AvailableSkusField: types.StringValue("ec_available_items"),
+		},
+	}
+
+	body, err := json.Marshal(in.toAPI())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":"store","product":{"idField":"ec_product_id","objectType":"Product"},"availability":{"idField":"ec_availability_id","objectType":"Availability","availableSkusField":"ec_available_items"}}`
+	if string(body) != want {
+		t.Errorf("request body = %s, want %s", body, want)
+	}
+
+	var config coveoCatalogConfiguration
+	if err := json.Unmarshal([]byte(`{"id":"cfg1",`+want[1:]), &config); err != nil {
+		t.Fatal(err)
+	}
+	var out coveoCatalogConfigurationModel
+	out.fromAPI(&config)
+	if out.ID.ValueString() != "cfg1" || out.Variant != nil {
+		t.Errorf("unexpected model %+v", out)
+	}
+	if out.Availability.AvailableSkusField.ValueString() != "ec_available_items" {
+		t.Errorf("availability not read back: %+v", out.Availability)
+	}
+}
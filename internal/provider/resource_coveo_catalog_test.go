@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoCatalogResource_UpdateWithoutResponseBody(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/catalogs/cat1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		switch r.Method {
+		case "PUT":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			fmt.Fprint(w, `{"id":"cat1","name":"Store","description":"","sourceId":"products","catalogConfigurationId":"conf1"}`)
+		}
+	})
+	r := &CoveoCatalogResource{client: client}
+	s := testResourceSchema(ctx, r)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	plan := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":                       str("cat1"),
+		"name":                     str("Store"),
+		"description":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"product_source_id":        str("products"),
+		"catalog_configuration_id": str("conf1"),
+	})
+	req := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: s, Raw: plan}, State: tfsdk.State{Schema: s, Raw: plan}}
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: plan}}
+	r.Update(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var got coveoCatalogModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.Description.IsUnknown() || got.Name.ValueString() != "Store" {
+		t.Errorf("unexpected state %+v", got)
+	}
+}
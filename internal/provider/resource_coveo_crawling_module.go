@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &CoveoCrawlingModuleResource{}
+	_ resource.ResourceWithValidateConfig = &CoveoCrawlingModuleResource{}
+)
+
+// maintenanceDays lists the days a maintenance window can start on.
+var maintenanceDays = []string{
+	"MONDAY",
+	"TUESDAY",
+	"WEDNESDAY",
+	"THURSDAY",
+	"FRIDAY",
+	"SATURDAY",
+	"SUNDAY",
+}
+
+// CoveoCrawlingModuleResource manages a Crawling Module, which crawls
+// on-premises content for the sources assigned to it.
+type CoveoCrawlingModuleResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoCrawlingModuleResource(client *CoveoClient) resource.Resource {
+	return &CoveoCrawlingModuleResource{client: client}
+}
+
+type coveoCrawlingModuleModel struct {
+	ID                types.String                 `tfsdk:"id"`
+	Name              types.String                 `tfsdk:"name"`
+	Workers           types.Int64                  `tfsdk:"workers"`
+	MaintenanceWindow *coveoMaintenanceWindowModel `tfsdk:"maintenance_window"`
+	Status            types.String                 `tfsdk:"status"`
+	Version           types.String                 `tfsdk:"version"`
+	RegistrationToken types.String                 `tfsdk:"registration_token"`
+}
+
+type coveoMaintenanceWindowModel struct {
+	DayOfWeek     types.String `tfsdk:"day_of_week"`
+	StartTime     types.String `tfsdk:"start_time"`
+	DurationHours types.Int64  `tfsdk:"duration_hours"`
+	TimeZone      types.String `tfsdk:"time_zone"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoCrawlingModuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_crawling_module"
+}
+
+// Schema defines the schema for the Crawling Module resource.
+func (r *CoveoCrawlingModuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo Crawling Module. The on-premises instance registers with the registration_token.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Crawling Module ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The Crawling Module name.",
+			},
+			"workers": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "The number of workers crawling content in parallel. Defaults to 1.",
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "When the Crawling Module may update and restart. Defaults to a window chosen by Coveo.",
+				Attributes: map[string]schema.Attribute{
+					"day_of_week": schema.StringAttribute{
+						Required:    true,
+						Description: "The day the window starts, such as SUNDAY.",
+					},
+					"start_time": schema.StringAttribute{
+						Required:    true,
+						Description: "The time the window starts, in 24-hour HH:MM format.",
+					},
+					"duration_hours": schema.Int64Attribute{
+						Required:    true,
+						Description: "The length of the window, in hours.",
+					},
+					"time_zone": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("UTC"),
+						Description: "The IANA time zone of start_time, such as America/Montreal. Defaults to UTC.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Crawling Module status, such as PENDING_REGISTRATION or ONLINE.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version the registered instance runs.",
+			},
+			"registration_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token the on-premises instance registers with. Only known for Crawling Modules created by Terraform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the worker count and maintenance window.
+func (r *CoveoCrawlingModuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoCrawlingModuleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.Workers; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("workers"), "Invalid Configuration", "workers must be at least 1.")
+	}
+	w := config.MaintenanceWindow
+	if w == nil {
+		return
+	}
+	windowPath := path.Root("maintenance_window")
+	if v := w.DayOfWeek; !v.IsNull() && !v.IsUnknown() && !oneOf(v.ValueString(), maintenanceDays) {
+		resp.Diagnostics.AddAttributeError(windowPath.AtName("day_of_week"), "Invalid Configuration",
+			fmt.Sprintf("day_of_week must be one of %s, got %q.", strings.Join(maintenanceDays, ", "), v.ValueString()))
+	}
+	if v := w.StartTime; !v.IsNull() && !v.IsUnknown() {
+		if _, err := time.Parse("15:04", v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(windowPath.AtName("start_time"), "Invalid Configuration",
+				fmt.Sprintf("start_time must be a time such as 02:00, got %q.", v.ValueString()))
+		}
+	}
+	if v := w.DurationHours; !v.IsNull() && !v.IsUnknown() && (v.ValueInt64() < 1 || v.ValueInt64() > 24) {
+		resp.Diagnostics.AddAttributeError(windowPath.AtName("duration_hours"), "Invalid Configuration", "duration_hours must be between 1 and 24.")
+	}
+	if v := w.TimeZone; !v.IsNull() && !v.IsUnknown() {
+		if _, err := time.LoadLocation(v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(windowPath.AtName("time_zone"), "Invalid Configuration", fmt.Sprintf("Unknown time zone %q.", v.ValueString()))
+		}
+	}
+}
+
+func (m *coveoCrawlingModuleModel) toAPI() coveo.CrawlingModule {
+	module := coveo.CrawlingModule{
+		ID:              m.ID.ValueString(),
+		Name:            m.Name.ValueString(),
+		NumberOfWorkers: m.Workers.ValueInt64(),
+	}
+	if w := m.MaintenanceWindow; w != nil {
+		module.MaintenanceWindow = &coveo.MaintenanceWindow{
+			DayOfWeek:     w.DayOfWeek.ValueString(),
+			StartTime:     w.StartTime.ValueString(),
+			DurationHours: w.DurationHours.ValueInt64(),
+			TimeZone:      w.TimeZone.ValueString(),
+		}
+	}
+	return module
+}
+
+// fromAPI sets the model from a Crawling Module. The maintenance window is
+// only read back when it is managed, since Coveo picks one otherwise.
+func (m *coveoCrawlingModuleModel) fromAPI(module *coveo.CrawlingModule) {
+	m.ID = types.StringValue(module.ID)
+	m.Name = types.StringValue(module.Name)
+	m.Workers = types.Int64Value(module.NumberOfWorkers)
+	if m.MaintenanceWindow != nil && module.MaintenanceWindow != nil {
+		m.MaintenanceWindow = &coveoMaintenanceWindowModel{
+			DayOfWeek:     types.StringValue(module.MaintenanceWindow.DayOfWeek),
+			StartTime:     types.StringValue(module.MaintenanceWindow.StartTime),
+			DurationHours: types.Int64Value(module.MaintenanceWindow.DurationHours),
+			TimeZone:      types.StringValue(module.MaintenanceWindow.TimeZone),
+		}
+	}
+	m.Status = types.StringValue(module.Status)
+	m.Version = types.StringValue(module.Version)
+}
+
+// Create declares the Crawling Module.
+func (r *CoveoCrawlingModuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoCrawlingModuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CrawlingModules.Create(ctx, plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create Crawling Module: %s", err))
+		return
+	}
+	plan.fromAPI(created)
+	plan.RegistrationToken = types.StringValue(created.RegistrationToken)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the configuration and registration status.
+func (r *CoveoCrawlingModuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoCrawlingModuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	module, err := r.client.CrawlingModules.Get(ctx, state.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read Crawling Module: %s", err))
+		return
+	}
+	state.fromAPI(module)
+	if state.RegistrationToken.IsNull() {
+		state.RegistrationToken = types.StringValue("")
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update changes the Crawling Module configuration.
+func (r *CoveoCrawlingModuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoCrawlingModuleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.CrawlingModules.Update(ctx, plan.toAPI()); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update Crawling Module: %s", err))
+		return
+	}
+	module, err := r.client.CrawlingModules.Get(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read Crawling Module: %s", err))
+		return
+	}
+	plan.fromAPI(module)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete unregisters the Crawling Module.
+func (r *CoveoCrawlingModuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoCrawlingModuleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CrawlingModules.Delete(ctx, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete Crawling Module: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a Crawling Module by ID. Its registration token is not
+// imported.
+func (r *CoveoCrawlingModuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+func TestCoveoCrawlingModuleResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &CoveoCrawlingModuleResource{}
+	s := testResourceSchema(ctx, r)
+	windowType := s.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["maintenance_window"].(tftypes.Object)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	window := func(day, start string, hours int64, zone string) tftypes.Value {
+		return tftypes.NewValue(windowType, map[string]tftypes.Value{
+			"day_of_week":    str(day),
+			"start_time":     str(start),
+			"duration_hours": tftypes.NewValue(tftypes.Number, hours),
+			"time_zone":      str(zone),
+		})
+	}
+
+	cases := map[string]struct {
+		window  tftypes.Value
+		wantErr bool
+	}{
+		"valid":            {window: window("SUNDAY", "02:00", 4, "America/Montreal"), wantErr: false},
+		"unknown day":      {window: window("SUN", "02:00", 4, "UTC"), wantErr: true},
+		"invalid time":     {window: window("SUNDAY", "2am", 4, "UTC"), wantErr: true},
+		"too long":         {window: window("SUNDAY", "02:00", 25, "UTC"), wantErr: true},
+		"unknown timezone": {window: window("SUNDAY", "02:00", 4, "Mars/Olympus"), wantErr: true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := tfsdk.Config{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+				"name":               str("onprem"),
+				"maintenance_window": c.window,
+			})}
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+			if got := resp.Diagnostics.HasError(); got != c.wantErr {
+				t.Errorf("HasError() = %v, want %v: %v", got, c.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCoveoCrawlingModuleModel_FromAPIUnmanagedWindow(t *testing.T) {
+	m := coveoCrawlingModuleModel{}
+	m.fromAPI(&coveo.CrawlingModule{
+		ID:                "cm1",
+		Name:              "onprem",
+		NumberOfWorkers:   1,
+		MaintenanceWindow: &coveo.MaintenanceWindow{DayOfWeek: "MONDAY", StartTime: "03:00", DurationHours: 2, TimeZone: "UTC"},
+	})
+	if m.MaintenanceWindow != nil {
+		t.Errorf("maintenance window chosen by Coveo was read into the state: %+v", m.MaintenanceWindow)
+	}
+}
+
+func TestCoveoCrawlingModuleResource_CreateStoresRegistrationToken(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/organizations/myorg/crawlingmodules" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var module coveo.CrawlingModule
+		if err := json.NewDecoder(r.Body).Decode(&module); err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(w, `{"id":"cm1","name":%q,"numberOfWorkers":%d,"status":"PENDING_REGISTRATION","registrationToken":"xx-token"}`, module.Name, module.NumberOfWorkers)
+	})
+	r := &CoveoCrawlingModuleResource{client: client}
+	s := testResourceSchema(ctx, r)
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":                 unknown,
+		"name":               tftypes.NewValue(tftypes.String, "onprem"),
+		"workers":            tftypes.NewValue(tftypes.Number, 2),
+		"status":             unknown,
+		"version":            unknown,
+		"registration_token": unknown,
+	})}}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var token, status string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("registration_token"), &token)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	if token != "xx-token" || status != "PENDING_REGISTRATION" {
+		t.Errorf("registration_token = %q, status = %q", token, status)
+	}
+}
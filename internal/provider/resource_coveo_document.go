@@ -2,14 +2,21 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
 )
 
+var _ resource.ResourceWithValidateConfig = &CoveoDocumentResource{}
+
 type CoveoDocumentResource struct {
     client *CoveoClient
 }
@@ -47,153 +54,166 @@ func (r *CoveoDocumentResource) Schema(ctx context.Context, req resource.SchemaR
                 Optional:    true,
                 Description: "The source ID where the document will be stored.",
             },
+            "wait_for_indexing": schema.BoolAttribute{
+                Optional:    true,
+                Computed:    true,
+                Default:     booldefault.StaticBool(false),
+                Description: "Wait until the document is searchable after each push. Indexing errors reported in the source logs fail the apply.",
+            },
+            "indexing_timeout": schema.StringAttribute{
+                Optional:    true,
+                Computed:    true,
+                Default:     stringdefault.StaticString(defaultIndexingTimeout),
+                Description: "How long to wait for the document to be indexed when wait_for_indexing is set, as a Go duration such as 5m.",
+            },
         },
     }
 }
 
-// Create sends a request to create a document in Coveo.
-func (r *CoveoDocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-    // Verify client initialization
-    if r.client == nil {
-        resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
-        return
-    }
+// coveoDocumentResourceModel maps the document resource schema data.
+type coveoDocumentResourceModel struct {
+    Title           string `tfsdk:"title"`
+    Content         string `tfsdk:"content"`
+    SourceID        string `tfsdk:"source_id"`
+    DocumentID      string `tfsdk:"document_id"`
+    WaitForIndexing types.Bool   `tfsdk:"wait_for_indexing"`
+    IndexingTimeout types.String `tfsdk:"indexing_timeout"`
+}
 
-    // Extract plan attributes
-    var plan struct {
-        Title    string `tfsdk:"title"`
-        Content  string `tfsdk:"content"`
-        SourceID string `tfsdk:"source_id"`
-        DocumentID string `tfsdk:"document_id"`
+// indexingTimeout returns indexing_timeout as a duration. It is null in
+// states written before the attribute existed, and then defaults.
+func (m coveoDocumentResourceModel) indexingTimeout() (time.Duration, error) {
+    if m.IndexingTimeout.IsNull() || m.IndexingTimeout.IsUnknown() {
+        return time.ParseDuration(defaultIndexingTimeout)
     }
-    diags := req.Plan.Get(ctx, &plan)
-    resp.Diagnostics.Append(diags...)
+    return time.ParseDuration(m.IndexingTimeout.ValueString())
+}
+
+// ValidateConfig checks that indexing_timeout is a duration.
+func (r *CoveoDocumentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+    var v types.String
+    resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("indexing_timeout"), &v)...)
     if resp.Diagnostics.HasError() {
         return
     }
 
-    // Define request payload
-    requestBody := map[string]interface{}{
-        "title":   plan.Title,
-        "content": plan.Content,
+    if !v.IsNull() && !v.IsUnknown() {
+        if _, err := time.ParseDuration(v.ValueString()); err != nil {
+            resp.Diagnostics.AddAttributeError(path.Root("indexing_timeout"), "Invalid Configuration", fmt.Sprintf("indexing_timeout must be a duration such as 5m: %s.", err))
+        }
     }
+}
 
-    // Define the endpoint for document creation in the specified source
-    endpoint := fmt.Sprintf("sources/%s/documents?documentId=%s", plan.SourceID, plan.DocumentID)
-    
-    // Make API request
-    body, err := r.client.DoRequest("PUT", endpoint, requestBody)
+// push sends the planned document to its source and, when requested, waits
+// for it to be indexed. Errors sending the document and errors indexing it
+// are returned separately, so that callers save the state of a document that
+// was sent even if it failed to index.
+func (r *CoveoDocumentResource) push(ctx context.Context, plan coveoDocumentResourceModel) (pushErr, indexErr error) {
+    timeout, err := plan.indexingTimeout()
     if err != nil {
-        resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create document: %s", err))
+        return err, nil
+    }
+
+    pushedAt := time.Now()
+    doc := coveo.Document{Title: plan.Title, Content: plan.Content}
+    if err := r.client.Push.PutDocument(ctx, plan.SourceID, plan.DocumentID, doc); err != nil {
+        return err, nil
+    }
+
+    if plan.WaitForIndexing.ValueBool() {
+        return nil, waitForDocumentIndexed(ctx, r.client, plan.SourceID, plan.DocumentID, pushedAt, timeout)
+    }
+    return nil, nil
+}
+
+// Create sends a request to create a document in Coveo.
+func (r *CoveoDocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+    // Verify client initialization
+    if r.client == nil {
+        resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
         return
     }
 
-    // Parse response
-    var responseBody map[string]interface{}
-    err = json.Unmarshal(body, &responseBody)
-    if err != nil {
-        resp.Diagnostics.AddError("Parse Error", "Could not parse response from Coveo API.")
+    var plan coveoDocumentResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+    if resp.Diagnostics.HasError() {
         return
     }
 
-    // Extract and set document ID
-    // documentID, ok := responseBody["id"].(string)
-    // if !ok || documentID == "" {
-    //     resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid document ID.")
-    //     return
-    // }
-    // diags = resp.State.SetAttribute(ctx, path.Root("id"), documentID)
-    // resp.Diagnostics.Append(diags...)
-
-    // Optionally, set other response attributes if they are part of the API response
-    // diags = resp.State.SetAttribute(ctx, path.Root("title"), plan.Title)
-    // resp.Diagnostics.Append(diags...)
-
-    diags = resp.State.SetAttribute(ctx, path.Root("title"), plan.Title)
-    diags = resp.State.SetAttribute(ctx, path.Root("source_id"), plan.SourceID)
-    diags = resp.State.SetAttribute(ctx, path.Root("document_id"), plan.DocumentID)
-    diags = resp.State.SetAttribute(ctx, path.Root("content"), plan.Content)
-    resp.Diagnostics.Append(diags...)
+    pushErr, indexErr := r.push(ctx, plan)
+    if pushErr != nil {
+        resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create document: %s", pushErr))
+        return
+    }
+    // Save the state before reporting an indexing error, so that the pushed
+    // document is tainted rather than orphaned.
+    resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+    if indexErr != nil {
+        resp.Diagnostics.AddError("Indexing Error", indexErr.Error())
+    }
 }
 
-
 // Read retrieves the document’s data.
 func (r *CoveoDocumentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-    var state struct {
-        SourceID string `tfsdk:"source_id"`
-        Title string `tfsdk:"title"`
-        DocumentID string `tfsdk:"document_id"`
-        Content string `tfsdk:"content"`
-    }
-    diags := req.State.Get(ctx, &state)
-    resp.Diagnostics.Append(diags...)
+    var state coveoDocumentResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
     if resp.Diagnostics.HasError() {
         return
     }
 
-    endpoint := fmt.Sprintf("sources/%s/documents/%s", state.SourceID, state.DocumentID)
-    body, err := r.client.DoRequest("GET", endpoint, nil)
-    if err != nil {
-        resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read document: %s", err))
+    doc, err := r.client.Push.GetDocument(ctx, state.SourceID, state.DocumentID)
+    if isNotFound(err) {
+        resp.State.RemoveResource(ctx)
         return
     }
-
-    var responseBody map[string]interface{}
-    err = json.Unmarshal(body, &responseBody)
     if err != nil {
-        resp.Diagnostics.AddError("Parse Error", "Could not parse response from Coveo API.")
+        resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read document: %s", err))
         return
     }
 
-    resp.State.SetAttribute(ctx, path.Root("title"), responseBody["title"])
-    resp.State.SetAttribute(ctx, path.Root("content"), responseBody["content"])
+    state.Title = doc.Title
+    state.Content = doc.Content
+    if state.WaitForIndexing.IsNull() {
+        state.WaitForIndexing = types.BoolValue(false)
+    }
+    if state.IndexingTimeout.IsNull() {
+        state.IndexingTimeout = types.StringValue(defaultIndexingTimeout)
+    }
+    resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 // Update modifies an existing document.
 func (r *CoveoDocumentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-    var plan struct {
-        DocumentID       string `tfsdk:"document_id"`
-        Title    string `tfsdk:"title"`
-        Content  string `tfsdk:"content"`
-        SourceID string `tfsdk:"source_id"`
-    }
-    diags := req.Plan.Get(ctx, &plan)
-    resp.Diagnostics.Append(diags...)
+    var plan coveoDocumentResourceModel
+    resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
     if resp.Diagnostics.HasError() {
         return
     }
 
-    requestBody := map[string]interface{}{
-        "title":   plan.Title,
-        "content": plan.Content,
-    }
-
-    endpoint := fmt.Sprintf("sources/%s/documents/%s", plan.SourceID, plan.DocumentID)
-    _, err := r.client.DoRequest("PUT", endpoint, requestBody)
-    if err != nil {
-        resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update document: %s", err))
+    pushErr, indexErr := r.push(ctx, plan)
+    if pushErr != nil {
+        resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update document: %s", pushErr))
         return
     }
+    // Save the state before reporting an indexing error, so that it matches
+    // the document that was pushed.
+    resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+    if indexErr != nil {
+        resp.Diagnostics.AddError("Indexing Error", indexErr.Error())
+    }
 }
 
 // Delete removes a document.
 func (r *CoveoDocumentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-    var state struct {
-        DocumentID       string `tfsdk:"document_id"`
-        SourceID string `tfsdk:"source_id"`
-    }
-    diags := req.State.Get(ctx, &state)
-    resp.Diagnostics.Append(diags...)
+    var state coveoDocumentResourceModel
+    resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
     if resp.Diagnostics.HasError() {
         return
     }
 
-    endpoint := fmt.Sprintf("sources/%s/documents/%s", state.SourceID, state.DocumentID)
-    _, err := r.client.DoRequest("DELETE", endpoint, nil)
-    if err != nil {
+    err := r.client.Push.DeleteDocument(ctx, state.SourceID, state.DocumentID)
+    if err != nil && !isNotFound(err) {
         resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete document: %s", err))
         return
     }
-
-    resp.State.RemoveResource(ctx)
 }
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoDocumentResource_CreateSavesStateOnIndexingError(t *testing.T) {
+	indexingPollInterval = time.Millisecond
+	t.Cleanup(func() { indexingPollInterval = 5 * time.Second })
+
+	ctx := context.Background()
+	var pushed bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT":
+			pushed = true
+			w.WriteHeader(http.StatusAccepted)
+		case strings.HasPrefix(r.URL.Path, "/logs/"):
+			fmt.Fprint(w, `[{"task":"STREAMING_EXTENSION","operation":"ADD","result":"ERROR","meta":{"error":"boom"}}]`)
+		default:
+			fmt.Fprint(w, `{"totalCount":0}`)
+		}
+	})
+	r := &CoveoDocumentResource{client: client}
+	s := testResourceSchema(ctx, r)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+		"title":             str("Doc"),
+		"content":           str("Hello"),
+		"source_id":         str("src"),
+		"document_id":       str("https://docs/1"),
+		"wait_for_indexing": tftypes.NewValue(tftypes.Bool, true),
+		"indexing_timeout":  str("1m"),
+	})}}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+
+	if !pushed {
+		t.Fatal("document was not pushed")
+	}
+	if !resp.Diagnostics.HasError() || !strings.Contains(fmt.Sprint(resp.Diagnostics), "boom") {
+		t.Errorf("expected an indexing error mentioning boom, got %v", resp.Diagnostics)
+	}
+	var documentID string
+	resp.State.GetAttribute(ctx, path.Root("document_id"), &documentID)
+	if documentID != "https://docs/1" {
+		t.Errorf("state document_id = %q, want the pushed document to be saved", documentID)
+	}
+}
+
+func TestCoveoDocumentResource_ReadStateWithoutIndexingAttributes(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"title":"Doc","content":"Hello"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusAccepted)
+		}
+	})
+	r := &CoveoDocumentResource{client: client}
+	s := testResourceSchema(ctx, r)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	// States written before wait_for_indexing and indexing_timeout existed
+	// have no value for them.
+	prior := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"title":       str("Doc"),
+		"content":     str("Hello"),
+		"source_id":   str("src"),
+		"document_id": str("https://docs/1"),
+	})
+
+	readResp := resource.ReadResponse{State: tfsdk.State{Schema: s, Raw: prior}}
+	r.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: prior}}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+	var state coveoDocumentResourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+	if state.WaitForIndexing.ValueBool() || state.IndexingTimeout.ValueString() != defaultIndexingTimeout {
+		t.Errorf("defaults not applied: wait_for_indexing = %s, indexing_timeout = %s", state.WaitForIndexing, state.IndexingTimeout)
+	}
+
+	deleteResp := resource.DeleteResponse{State: tfsdk.State{Schema: s, Raw: prior}}
+	r.Delete(ctx, resource.DeleteRequest{State: tfsdk.State{Schema: s, Raw: prior}}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatal(deleteResp.Diagnostics)
+	}
+}
+
+func TestCoveoDocumentResource_ValidateConfigIndexingTimeout(t *testing.T) {
+	ctx := context.Background()
+	r := &CoveoDocumentResource{}
+	s := testResourceSchema(ctx, r)
+
+	for timeout, wantErr := range map[string]bool{"90s": false, "5 minutes": true} {
+		config := tfsdk.Config{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+			"title":            tftypes.NewValue(tftypes.String, "Doc"),
+			"content":          tftypes.NewValue(tftypes.String, "Hello"),
+			"source_id":        tftypes.NewValue(tftypes.String, "src"),
+			"indexing_timeout": tftypes.NewValue(tftypes.String, timeout),
+		})}
+
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		if got := resp.Diagnostics.HasError(); got != wantErr {
+			t.Errorf("indexing_timeout %q: HasError() = %v, want %v: %v", timeout, got, wantErr, resp.Diagnostics)
+		}
+	}
+}
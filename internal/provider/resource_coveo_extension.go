@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &CoveoExtensionResource{}
+	_ resource.ResourceWithModifyPlan  = &CoveoExtensionResource{}
+)
+
+// coveoExtension is the indexing pipeline extension model of the Platform API.
+type coveoExtension struct {
+	ID                  string   `json:"id,omitempty"`
+	Name                string   `json:"name"`
+	Description         string   `json:"description"`
+	Content             string   `json:"content"`
+	RequiredDataStreams []string `json:"requiredDataStreams"`
+	Timeout             int64    `json:"timeout,omitempty"`
+	VersionID           string   `json:"versionId,omitempty"`
+}
+
+// coveoExtensionVersion is an entry of the extension version history.
+type coveoExtensionVersion struct {
+	ID string `json:"id"`
+}
+
+// CoveoExtensionResource manages an indexing pipeline extension.
+type CoveoExtensionResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoExtensionResource(client *CoveoClient) resource.Resource {
+	return &CoveoExtensionResource{client: client}
+}
+
+type coveoExtensionModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	Content             types.String `tfsdk:"content"`
+	ContentFile         types.String `tfsdk:"content_file"`
+	RequiredDataStreams types.Set    `tfsdk:"required_data_streams"`
+	Timeout             types.Int64  `tfsdk:"timeout"`
+	VersionID           types.String `tfsdk:"version_id"`
+	Versions            types.List   `tfsdk:"versions"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoExtensionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_extension"
+}
+
+// Schema defines the schema for the extension resource.
+func (r *CoveoExtensionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo indexing pipeline extension.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The extension ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The extension name.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The extension description.",
+			},
+			"content": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The Python source of the extension. Exactly one of content or content_file must be set.",
+			},
+			"content_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file holding the Python source of the extension. Exactly one of content or content_file must be set.",
+			},
+			"required_data_streams": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The data streams the extension reads or writes, such as BODY_TEXT, BODY_HTML, THUMBNAIL or DOCUMENT_DATA.",
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum execution time of the extension, in seconds.",
+			},
+			"version_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the current version of the extension.",
+			},
+			"versions": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of all versions of the extension, most recent first.",
+			},
+		},
+	}
+}
+
+// ModifyPlan loads content_file into content so that changes to the file show
+// up as a diff on content.
+func (r *CoveoExtensionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan coveoExtensionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config coveoExtensionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ContentFile.IsUnknown() || config.Content.IsUnknown() {
+		return
+	}
+	if config.ContentFile.IsNull() == config.Content.IsNull() {
+		resp.Diagnostics.AddError("Invalid Configuration", "Exactly one of content or content_file must be set.")
+		return
+	}
+	if config.ContentFile.IsNull() {
+		return
+	}
+
+	content, err := os.ReadFile(config.ContentFile.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content_file"), "Invalid Configuration", fmt.Sprintf("Could not read content_file: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content"), string(content))...)
+}
+
+// toAPI builds the API model from the plan.
+func (m *coveoExtensionModel) toAPI(ctx context.Context) (coveoExtension, error) {
+	ext := coveoExtension{
+		Name:                m.Name.ValueString(),
+		Description:         m.Description.ValueString(),
+		Content:             m.Content.ValueString(),
+		RequiredDataStreams: []string{},
+		Timeout:             m.Timeout.ValueInt64(),
+	}
+	if !m.RequiredDataStreams.IsNull() && !m.RequiredDataStreams.IsUnknown() {
+		if diags := m.RequiredDataStreams.ElementsAs(ctx, &ext.RequiredDataStreams, false); diags.HasError() {
+			return ext, fmt.Errorf("invalid required_data_streams")
+		}
+	}
+	return ext, nil
+}
+
+// refresh reads the extension and its versions back into the model.
+func (r *CoveoExtensionResource) refresh(ctx context.Context, m *coveoExtensionModel) error {
+	escaped := url.PathEscape(m.ID.ValueString())
+	body, err := r.client.DoPlatformRequest(ctx, "GET", "extensions/"+escaped, nil)
+	if err != nil {
+		return err
+	}
+	var ext coveoExtension
+	if err := json.Unmarshal(body, &ext); err != nil {
+		return err
+	}
+
+	body, err = r.client.DoPlatformRequest(ctx, "GET", "extensions/"+escaped+"/versions", nil)
+	if err != nil {
+		return err
+	}
+	var versions []coveoExtensionVersion
+	if err := json.Unmarshal(body, &versions); err != nil {
+		return err
+	}
+	versionIDs := make([]string, 0, len(versions))
+	for _, v := range versions {
+		versionIDs = append(versionIDs, v.ID)
+	}
+
+	m.Name = types.StringValue(ext.Name)
+	m.Description = types.StringValue(ext.Description)
+	m.Content = types.StringValue(ext.Content)
+	m.Timeout = types.Int64Value(ext.Timeout)
+	m.VersionID = types.StringValue(ext.VersionID)
+	streams, diags := types.SetValueFrom(ctx, types.StringType, ext.RequiredDataStreams)
+	if diags.HasError() {
+		return fmt.Errorf("invalid required data streams in response")
+	}
+	m.RequiredDataStreams = streams
+	list, diags := types.ListValueFrom(ctx, types.StringType, versionIDs)
+	if diags.HasError() {
+		return fmt.Errorf("invalid versions in response")
+	}
+	m.Versions = list
+	return nil
+}
+
+// Create uploads a new extension.
+func (r *CoveoExtensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoExtensionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ext, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	body, err := r.client.DoPlatformRequest(ctx, "POST", "extensions", ext)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create extension: %s", err))
+		return
+	}
+	var created coveoExtension
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid extension ID.")
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	if err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read extension: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the extension.
+func (r *CoveoExtensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoExtensionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.refresh(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read extension: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update saves a new version of the extension.
+func (r *CoveoExtensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoExtensionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ext, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	_, err = r.client.DoPlatformRequest(ctx, "PUT", "extensions/"+url.PathEscape(plan.ID.ValueString()), ext)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update extension: %s", err))
+		return
+	}
+
+	if err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read extension: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the extension.
+func (r *CoveoExtensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoExtensionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", "extensions/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete extension: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports an extension by ID.
+func (r *CoveoExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoExtensionResource_ModifyPlanLoadsContentFile(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "extension.py")
+	if err := os.WriteFile(file, []byte("document.add_meta_data({'ok': True})"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &CoveoExtensionResource{}
+	s := testResourceSchema(ctx, r)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	config := map[string]tftypes.Value{
+		"name":         str("tagger"),
+		"content_file": str(file),
+	}
+	plan := map[string]tftypes.Value{"id": unknown, "content": unknown, "version_id": unknown}
+	for name, v := range config {
+		plan[name] = v
+	}
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: testObjectValue(ctx, s, config)},
+		Plan:   tfsdk.Plan{Schema: s, Raw: testObjectValue(ctx, s, plan)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	var got coveoExtensionModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+	if got.Content.ValueString() != "document.add_meta_data({'ok': True})" {
+		t.Errorf("content = %s", got.Content)
+	}
+
+	for name, values := range map[string]map[string]tftypes.Value{
+		"missing file": {"name": str("tagger"), "content_file": str(filepath.Join(filepath.Dir(file), "missing.py"))},
+		"both set":     {"name": str("tagger"), "content_file": str(file), "content": str("pass")},
+		"neither set":  {"name": str("tagger")},
+	} {
+		req.Config.Raw = testObjectValue(ctx, s, values)
+		resp = resource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, &resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestCoveoExtensionResource_Refresh(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/organizations/myorg/extensions/ext1":
+			fmt.Fprint(w, `{"id":"ext1","name":"tagger","description":"","content":"pass","requiredDataStreams":["BODY_TEXT"],"timeout":5,"versionId":"v3"}`)
+		case "/rest/organizations/myorg/extensions/ext1/versions":
+			fmt.Fprint(w, `[{"id":"v3"},{"id":"v2"},{"id":"v1"}]`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	r := &CoveoExtensionResource{client: client}
+
+	m := coveoExtensionModel{ID: types.StringValue("ext1"), ContentFile: types.StringValue("extension.py")}
+	if err := r.refresh(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	var versions, streams []string
+	m.Versions.ElementsAs(context.Background(), &versions, false)
+	m.RequiredDataStreams.ElementsAs(context.Background(), &streams, false)
+	if m.VersionID.ValueString() != "v3" || fmt.Sprint(versions) != "[v3 v2 v1]" {
+		t.Errorf("version_id = %s, versions = %v", m.VersionID, versions)
+	}
+	if m.Content.ValueString() != "pass" || m.Timeout.ValueInt64() != 5 || fmt.Sprint(streams) != "[BODY_TEXT]" {
+		t.Errorf("unexpected model %+v", m)
+	}
+	if m.ContentFile.ValueString() != "extension.py" {
+		t.Errorf("content_file = %s, want it kept", m.ContentFile)
+	}
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &CoveoGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &CoveoGroupResource{}
+)
+
+// coveoRealmRef references an identity realm by ID.
+type coveoRealmRef struct {
+	ID string `json:"id"`
+}
+
+// coveoGroup is the access control group model of the Platform API.
+type coveoGroup struct {
+	ID          string           `json:"id,omitempty"`
+	DisplayName string           `json:"displayName"`
+	Privileges  []coveoPrivilege `json:"privileges"`
+	Realms      []coveoRealmRef  `json:"realms"`
+}
+
+// CoveoGroupResource manages an access control group of the organization.
+type CoveoGroupResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoGroupResource(client *CoveoClient) resource.Resource {
+	return &CoveoGroupResource{client: client}
+}
+
+type coveoGroupModel struct {
+	ID          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Realms      types.Set    `tfsdk:"realms"`
+	Privileges  types.Set    `tfsdk:"privilege"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_group"
+}
+
+// Schema defines the schema for the group resource.
+func (r *CoveoGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo organization group and the privileges granted to its members.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The group ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The group name.",
+			},
+			"realms": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the identity realms whose users may be members of the group.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"privilege": privilegeBlock(),
+		},
+	}
+}
+
+// ModifyPlan validates the privileges against the privileges available in the
+// organization, so that typos fail at plan time.
+func (r *CoveoGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan coveoGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Privileges.IsUnknown() {
+		return
+	}
+
+	privileges, err := expandPrivileges(ctx, plan.Privileges)
+	if err != nil || len(privileges) == 0 {
+		return
+	}
+	catalog, err := listPrivileges(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to list organization privileges: %s", err))
+		return
+	}
+	if err := validatePrivileges(privileges, catalog); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("privilege"), "Invalid Configuration", err.Error())
+	}
+}
+
+func (m *coveoGroupModel) toAPI(ctx context.Context) (coveoGroup, error) {
+	group := coveoGroup{
+		DisplayName: m.DisplayName.ValueString(),
+		Realms:      []coveoRealmRef{},
+	}
+	privileges, err := expandPrivileges(ctx, m.Privileges)
+	if err != nil {
+		return group, err
+	}
+	group.Privileges = privileges
+
+	var realms []string
+	if diags := m.Realms.ElementsAs(ctx, &realms, false); diags.HasError() {
+		return group, fmt.Errorf("invalid realms")
+	}
+	for _, id := range realms {
+		group.Realms = append(group.Realms, coveoRealmRef{ID: id})
+	}
+	return group, nil
+}
+
+func (r *CoveoGroupResource) read(ctx context.Context, m *coveoGroupModel) error {
+	body, err := r.client.DoPlatformRequest(ctx, "GET", "groups/"+url.PathEscape(m.ID.ValueString()), nil)
+	if err != nil {
+		return err
+	}
+	var group coveoGroup
+	if err := json.Unmarshal(body, &group); err != nil {
+		return err
+	}
+
+	m.DisplayName = types.StringValue(group.DisplayName)
+	realms := make([]string, 0, len(group.Realms))
+	for _, realm := range group.Realms {
+		realms = append(realms, realm.ID)
+	}
+	if m.Realms, err = stringSetOrNull(ctx, m.Realms, realms); err != nil {
+		return err
+	}
+	if m.Privileges, err = flattenPrivileges(ctx, group.Privileges); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Create creates the group.
+func (r *CoveoGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	body, err := r.client.DoPlatformRequest(ctx, "POST", "groups", group)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create group: %s", err))
+		return
+	}
+	var created coveoGroup
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid group ID.")
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the group.
+func (r *CoveoGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read group: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the group name, realms and privileges. Members are kept.
+func (r *CoveoGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	group.ID = plan.ID.ValueString()
+	if _, err := r.client.DoPlatformRequest(ctx, "PUT", "groups/"+url.PathEscape(group.ID), group); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update group: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the group.
+func (r *CoveoGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoGroupModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", "groups/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete group: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a group by ID.
+func (r *CoveoGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
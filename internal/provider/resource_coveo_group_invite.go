@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoGroupInviteResource{}
+
+// CoveoGroupInviteResource invites a user to join a group.
+type CoveoGroupInviteResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoGroupInviteResource(client *CoveoClient) resource.Resource {
+	return &CoveoGroupInviteResource{client: client}
+}
+
+type coveoGroupInviteModel struct {
+	ID        types.String `tfsdk:"id"`
+	GroupID   types.String `tfsdk:"group_id"`
+	Email     types.String `tfsdk:"email"`
+	SendEmail types.Bool   `tfsdk:"send_email"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoGroupInviteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_group_invite"
+}
+
+// Schema defines the schema for the group invite resource.
+func (r *CoveoGroupInviteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Invites a user to join a Coveo organization group. Once accepted, the invite is kept in state as long as the user stays a member of the group.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The resource ID, in the form group_id/email.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "The email of the invited user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"send_email": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether Coveo emails the invitation to the user. Defaults to true.",
+				PlanModifiers: []planmodifier.Bool{
+					// Imported invites don't know whether an email was sent,
+					// so setting send_email on them doesn't replace them.
+					boolplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Changing send_email sends the invite again, except on imported invites.", "Changing send_email sends the invite again, except on imported invites."),
+				},
+			},
+		},
+	}
+}
+
+// Create sends the invite.
+func (r *CoveoGroupInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoGroupInviteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("groups/%s/invites?sendEmailToInvitedUsers=%t", url.PathEscape(plan.GroupID.ValueString()), plan.SendEmail.ValueBool())
+	invite := coveoGroupMember{Username: plan.Email.ValueString()}
+	if _, err := r.client.DoPlatformRequest(ctx, "POST", endpoint, invite); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to invite group member: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.GroupID.ValueString(), plan.Email.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read checks that the invite is still pending or has been accepted.
+func (r *CoveoGroupInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoGroupInviteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, email := state.GroupID.ValueString(), state.Email.ValueString()
+	found, err := hasGroupUser(ctx, r.client, groupID, "invites", email)
+	if err == nil && !found {
+		found, err = hasGroupUser(ctx, r.client, groupID, "members", email)
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read group invites: %s", err))
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores send_email on imported invites: every other change
+// requires replacement.
+func (r *CoveoGroupInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoGroupInviteModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete revokes the invite, or removes the user from the group when the
+// invite was accepted.
+func (r *CoveoGroupInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoGroupInviteModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, email := url.PathEscape(state.GroupID.ValueString()), url.PathEscape(state.Email.ValueString())
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", fmt.Sprintf("groups/%s/invites/%s", groupID, email), nil)
+	if isNotFound(err) {
+		_, err = r.client.DoPlatformRequest(ctx, "DELETE", fmt.Sprintf("groups/%s/members/%s", groupID, email), nil)
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete group invite: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports an invite from an ID in the form group_id/email.
+// send_email is left null, and takes its configured value on the next apply
+// without sending the invite again.
+func (r *CoveoGroupInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "group_id", "email")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[1])...)
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoGroupMemberResource{}
+
+// coveoGroupMember is a member or a pending invite of a group.
+type coveoGroupMember struct {
+	Username string `json:"username"`
+}
+
+// listGroupUsers lists the members or the pending invites of a group,
+// depending on kind.
+func listGroupUsers(ctx context.Context, client *CoveoClient, groupID, kind string) ([]coveoGroupMember, error) {
+	body, err := client.DoPlatformRequest(ctx, "GET", fmt.Sprintf("groups/%s/%s", url.PathEscape(groupID), kind), nil)
+	if err != nil {
+		return nil, err
+	}
+	var users []coveoGroupMember
+	if err := json.Unmarshal(body, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// hasGroupUser reports whether username is among the members or the pending
+// invites of a group, depending on kind. Usernames are compared
+// case-insensitively, as emails are.
+func hasGroupUser(ctx context.Context, client *CoveoClient, groupID, kind, username string) (bool, error) {
+	users, err := listGroupUsers(ctx, client, groupID, kind)
+	if err != nil {
+		return false, err
+	}
+	for _, u := range users {
+		if strings.EqualFold(u.Username, username) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CoveoGroupMemberResource adds an existing user of the organization to a
+// group.
+type CoveoGroupMemberResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoGroupMemberResource(client *CoveoClient) resource.Resource {
+	return &CoveoGroupMemberResource{client: client}
+}
+
+type coveoGroupMemberModel struct {
+	ID       types.String `tfsdk:"id"`
+	GroupID  types.String `tfsdk:"group_id"`
+	Username types.String `tfsdk:"username"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoGroupMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_group_member"
+}
+
+// Schema defines the schema for the group member resource.
+func (r *CoveoGroupMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Adds a user to a Coveo organization group. Use coveo_group_invite for users who are not yet members of the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The resource ID, in the form group_id/username.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the group.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The username of the member, such as jdoe@example.com-google.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create adds the user to the group.
+func (r *CoveoGroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoGroupMemberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("groups/%s/members?sendEmailToInvitedUsers=false", url.PathEscape(plan.GroupID.ValueString()))
+	member := coveoGroupMember{Username: plan.Username.ValueString()}
+	if _, err := r.client.DoPlatformRequest(ctx, "POST", endpoint, member); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to add group member: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.GroupID.ValueString(), plan.Username.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read checks that the user is still a member of the group.
+func (r *CoveoGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoGroupMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := hasGroupUser(ctx, r.client, state.GroupID.ValueString(), "members", state.Username.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read group members: %s", err))
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is never called: every attribute requires replacement.
+func (r *CoveoGroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoGroupMemberModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the user from the group.
+func (r *CoveoGroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoGroupMemberModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("groups/%s/members/%s", url.PathEscape(state.GroupID.ValueString()), url.PathEscape(state.Username.ValueString()))
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to remove group member: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a member from an ID in the form group_id/username.
+func (r *CoveoGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "group_id", "username")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), parts[1])...)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoGroupResource_ReadRealms(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/groups/g1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id":"g1","displayName":"Support","privileges":[],"realms":[]}`)
+	})
+	r := &CoveoGroupResource{client: client}
+
+	cases := map[string]struct {
+		prior    types.Set
+		wantNull bool
+	}{
+		"omitted":          {prior: types.SetNull(types.StringType), wantNull: true},
+		"configured empty": {prior: types.SetValueMust(types.StringType, []attr.Value{}), wantNull: false},
+		"removed in Coveo": {prior: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("realm1")}), wantNull: false},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			m := coveoGroupModel{ID: types.StringValue("g1"), Realms: c.prior}
+			if err := r.read(context.Background(), &m); err != nil {
+				t.Fatal(err)
+			}
+			if m.Realms.IsNull() != c.wantNull || len(m.Realms.Elements()) != 0 {
+				t.Errorf("realms = %s, want null %v and no elements", m.Realms, c.wantNull)
+			}
+		})
+	}
+}
+
+func TestCoveoGroupInviteResource_SendEmailReplace(t *testing.T) {
+	ctx := context.Background()
+	s := testResourceSchema(ctx, &CoveoGroupInviteResource{})
+	modifiers := s.Attributes["send_email"].(schema.BoolAttribute).PlanModifiers
+	state := tfsdk.State{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "grp/jane@example.com"),
+	})}
+
+	cases := map[string]struct {
+		state types.Bool
+		want  bool
+	}{
+		"imported": {state: types.BoolNull(), want: false},
+		"changed":  {state: types.BoolValue(true), want: true},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.BoolRequest{State: state, Plan: tfsdk.Plan{Schema: s, Raw: state.Raw}, StateValue: c.state, PlanValue: types.BoolValue(false)}
+			var resp planmodifier.BoolResponse
+			for _, m := range modifiers {
+				m.PlanModifyBool(ctx, req, &resp)
+			}
+			if resp.RequiresReplace != c.want {
+				t.Errorf("RequiresReplace = %v, want %v", resp.RequiresReplace, c.want)
+			}
+		})
+	}
+}
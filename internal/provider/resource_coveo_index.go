@@ -2,170 +2,374 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coveo/internal/coveo"
 )
 
-func NewCoveoIndexResource(client *CoveoClient) resource.Resource {
-    return &CoveoIndexResource{client: client}
+var (
+	_ resource.ResourceWithImportState    = &CoveoIndexResource{}
+	_ resource.ResourceWithValidateConfig = &CoveoIndexResource{}
+)
+
+// indexPollInterval is the delay between two checks while waiting for an
+// index to come online.
+var indexPollInterval = 15 * time.Second
+
+// defaultIndexOnlineTimeout is used when online_timeout is not set.
+// Provisioning an index usually takes several minutes.
+const defaultIndexOnlineTimeout = "45m"
+
+// waitForIndexOnline polls the index until it is online, and fails as soon as
+// the Platform API reports its provisioning failed.
+func waitForIndexOnline(ctx context.Context, client *CoveoClient, id string, timeout time.Duration) (*coveo.Index, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(indexPollInterval)
+	defer ticker.Stop()
+
+	for {
+		index, err := client.Indexes.Get(ctx, id)
+		if err != nil && ctx.Err() == nil {
+			return nil, fmt.Errorf("reading index: %w", err)
+		}
+		if err == nil {
+			if index.Online {
+				return index, nil
+			}
+			if index.Status == coveo.IndexStatusFailed {
+				return nil, fmt.Errorf("provisioning of index %s failed", id)
+			}
+			tflog.Debug(ctx, "Index not online yet", map[string]interface{}{"index_id": id, "status": index.Status})
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("index %s was not online within %s", id, timeout)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
 }
 
+// CoveoIndexResource manages a physical index of the organization.
 type CoveoIndexResource struct {
-    client *CoveoClient
+	client *CoveoClient
+}
+
+func NewCoveoIndexResource(client *CoveoClient) resource.Resource {
+	return &CoveoIndexResource{client: client}
+}
+
+type coveoIndexResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	LogicalIndex       types.String `tfsdk:"logical_index"`
+	Region             types.String `tfsdk:"region"`
+	MachineSpec        types.String `tfsdk:"machine_spec"`
+	StorageGiB         types.Int64  `tfsdk:"storage_gib"`
+	Replicas           types.Int64  `tfsdk:"replicas"`
+	Slices             types.Int64  `tfsdk:"slices"`
+	Status             types.String `tfsdk:"status"`
+	Online             types.Bool   `tfsdk:"online"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	OnlineTimeout      types.String `tfsdk:"online_timeout"`
 }
 
+// Metadata sets the resource type name.
 func (r *CoveoIndexResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-    resp.TypeName = "coveo_index"
+	resp.TypeName = "coveo_index"
 }
 
+// Schema defines the schema for the index resource.
 func (r *CoveoIndexResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-    resp.Schema = schema.Schema{
-        Attributes: map[string]schema.Attribute{
-            "name": schema.StringAttribute{
-                Required: true,
-                Description: "The name of the Coveo index.",
-            },
-            // Add other necessary attributes here
-        },
-    }
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo physical index. Creating an index waits until it is online.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The index ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"logical_index": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The logical index the index serves. Defaults to the organization default logical index.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region hosting the index, such as us-east-1. Defaults to the organization region.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"machine_spec": schema.StringAttribute{
+				Required:    true,
+				Description: "The machine architecture of the index, such as T3_MEDIUM.",
+			},
+			"storage_gib": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The disk size of each index machine, in GiB.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"replicas": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "The number of copies of each slice serving queries.",
+			},
+			"slices": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "The number of slices the documents are split across. Changing it recreates the index.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The index status, such as PROVISIONING or ONLINE.",
+			},
+			"online": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the index is serving queries.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether Terraform refuses to destroy the index. Set it to false and apply before destroying the index.",
+			},
+			"online_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultIndexOnlineTimeout),
+				Description: "How long to wait for the index to come online after it is created or resized, as a Go duration such as 45m.",
+			},
+		},
+	}
 }
 
-func (r *CoveoIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-    // Extract the attributes from the Terraform configuration.
-    if r.client == nil {
-        resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
-        return
-    }
-	var plan struct {
-        Name        string `tfsdk:"name"`
-    }
-    diags := req.Plan.Get(ctx, &plan)
-    resp.Diagnostics.Append(diags...)
-    if resp.Diagnostics.HasError() {
-        return
-    }
-
-    // Define the request body for the API to create the index.
-    requestBody := map[string]interface{}{
-        "name":        plan.Name,
-    }
-
-    // Construct the URL for the Coveo index creation API.
-    endpoint := "indexes"
-    body, err := r.client.DoRequest("POST", endpoint, requestBody)
-    if err != nil {
-        resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create Coveo index: %s", err))
-        return
-    }
-
-    // Parse the response to extract the index ID.
-    var responseBody map[string]interface{}
-    err = json.Unmarshal(body, &responseBody)
-    if err != nil {
-        resp.Diagnostics.AddError("Parse Error", "Could not parse response from Coveo API.")
-        return
-    }
-
-    // Get the index ID from the response.
-    indexID, ok := responseBody["id"].(string)
-    if !ok || indexID == "" {
-        resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid index ID.")
-        return
-    }
-
-    // Update the Terraform state with the new index ID and name.
-    diags = resp.State.SetAttribute(ctx, path.Root("id"), indexID)
-    resp.Diagnostics.Append(diags...)
-    diags = resp.State.SetAttribute(ctx, path.Root("name"), plan.Name)
-    resp.Diagnostics.Append(diags...)
+// ValidateConfig checks that online_timeout is a duration.
+func (r *CoveoIndexResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoIndexResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.OnlineTimeout; !v.IsNull() && !v.IsUnknown() {
+		if _, err := time.ParseDuration(v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("online_timeout"), "Invalid Configuration", fmt.Sprintf("online_timeout must be a duration such as 45m: %s.", err))
+		}
+	}
 }
 
+func (m *coveoIndexResourceModel) toAPI() coveo.Index {
+	return coveo.Index{
+		ID:           m.ID.ValueString(),
+		LogicalIndex: m.LogicalIndex.ValueString(),
+		Region:       m.Region.ValueString(),
+		MachineSpec: &coveo.MachineSpec{
+			Architecture: m.MachineSpec.ValueString(),
+			StorageSpec: coveo.StorageSpec{
+				NumberOfGibibytes: m.StorageGiB.ValueInt64(),
+			},
+		},
+		Replicas: m.Replicas.ValueInt64(),
+		Slices:   m.Slices.ValueInt64(),
+	}
+}
 
+func (m *coveoIndexResourceModel) fromAPI(index *coveo.Index) {
+	m.ID = types.StringValue(index.ID)
+	m.LogicalIndex = types.StringValue(index.LogicalIndex)
+	m.Region = types.StringValue(index.Region)
+	if index.MachineSpec != nil {
+		m.MachineSpec = types.StringValue(index.MachineSpec.Architecture)
+		m.StorageGiB = types.Int64Value(index.MachineSpec.StorageSpec.NumberOfGibibytes)
+	}
+	m.Replicas = types.Int64Value(index.Replicas)
+	m.Slices = types.Int64Value(index.Slices)
+	m.Status = types.StringValue(index.Status)
+	m.Online = types.BoolValue(index.Online)
+}
 
+// pending returns the planned model of an index that was just created, with
+// the values only known once it is online left null.
+func (m coveoIndexResourceModel) pending(id string) coveoIndexResourceModel {
+	m.ID = types.StringValue(id)
+	if m.LogicalIndex.IsUnknown() {
+		m.LogicalIndex = types.StringNull()
+	}
+	if m.Region.IsUnknown() {
+		m.Region = types.StringNull()
+	}
+	if m.StorageGiB.IsUnknown() {
+		m.StorageGiB = types.Int64Null()
+	}
+	m.Status = types.StringNull()
+	m.Online = types.BoolValue(false)
+	return m
+}
 
-func (r *CoveoIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Extract the ID from the current state.
-	var state struct {
-		ID string `tfsdk:"id"`
+// Create provisions the index and waits for it to come online.
+func (r *CoveoIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
 	}
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+
+	var plan coveoIndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
-	// Make the API request to get the index details.
-	url := fmt.Sprintf("https://platform.cloud.coveo.com/rest/organizations/<org_id>/indexes/%s", state.ID)
-	body, err := r.client.DoRequest("GET", url, nil)
+	timeout, err := time.ParseDuration(plan.OnlineTimeout.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read Coveo index: %s", err))
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("online_timeout must be a duration such as 45m: %s", err))
 		return
 	}
 
-	// Parse and set response attributes in the Terraform state.
-	var responseBody map[string]interface{}
-	err = json.Unmarshal(body, &responseBody)
+	id, err := r.client.Indexes.Create(ctx, plan.toAPI())
 	if err != nil {
-		resp.Diagnostics.AddError("Parse Error", "Could not parse response from Coveo API.")
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create index: %s", err))
+		return
+	}
+	// Save the planned index right away so that one which never comes online
+	// is still tracked, with its deletion_protection, and replaced on the next
+	// apply.
+	pending := plan.pending(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &pending)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.State.SetAttribute(ctx, path.Root("name"), responseBody["name"])
+	index, err := waitForIndexOnline(ctx, r.client, id, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Provisioning Error", err.Error())
+		return
+	}
+	plan = pending
+	plan.fromAPI(index)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *CoveoIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Extract current state and planned changes.
-	var plan, state struct {
-		ID   string `tfsdk:"id"`
-		Name string `tfsdk:"name"`
-	}
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
+// Read refreshes the index configuration and status.
+func (r *CoveoIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoIndexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Define request body with updated values.
-	requestBody := map[string]interface{}{
-		"name": plan.Name,
+	index, err := r.client.Indexes.Get(ctx, state.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read index: %s", err))
+		return
+	}
+	state.fromAPI(index)
+	if state.DeletionProtection.IsNull() {
+		// Imported indexes are protected until configured otherwise.
+		state.DeletionProtection = types.BoolValue(true)
+	}
+	if state.OnlineTimeout.IsNull() {
+		state.OnlineTimeout = types.StringValue(defaultIndexOnlineTimeout)
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
 
-	// Make the API request to update the index.
-	url := fmt.Sprintf("https://platform.cloud.coveo.com/rest/organizations/<org_id>/indexes/%s", state.ID)
-	_, err := r.client.DoRequest("PUT", url, requestBody)
+// Update resizes the index and waits for it to come back online. Changes to
+// deletion_protection and online_timeout only update the state.
+func (r *CoveoIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state coveoIndexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, err := time.ParseDuration(plan.OnlineTimeout.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update Coveo index: %s", err))
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("online_timeout must be a duration such as 45m: %s", err))
 		return
 	}
 
-	// Update state with new values.
-	resp.State.SetAttribute(ctx, path.Root("name"), plan.Name)
+	index, err := r.client.Indexes.Get(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read index: %s", err))
+		return
+	}
+	if !plan.MachineSpec.Equal(state.MachineSpec) || !plan.StorageGiB.Equal(state.StorageGiB) || !plan.Replicas.Equal(state.Replicas) {
+		if err := r.client.Indexes.Update(ctx, plan.toAPI()); err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update index: %s", err))
+			return
+		}
+		if index, err = waitForIndexOnline(ctx, r.client, plan.ID.ValueString(), timeout); err != nil {
+			resp.Diagnostics.AddError("Provisioning Error", err.Error())
+			return
+		}
+	}
+	plan.fromAPI(index)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-
+// Delete removes the index unless deletion_protection is set.
 func (r *CoveoIndexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Extract the ID from the current state.
-	var state struct {
-		ID string `tfsdk:"id"`
-	}
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	var state coveoIndexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Make the API request to delete the index.
-	url := fmt.Sprintf("https://platform.cloud.coveo.com/rest/organizations/<org_id>/indexes/%s", state.ID)
-	_, err := r.client.DoRequest("DELETE", url, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete Coveo index: %s", err))
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protected",
+			fmt.Sprintf("Index %s has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", state.ID.ValueString()),
+		)
 		return
 	}
 
-	// Remove the ID from Terraform state.
+	err := r.client.Indexes.Delete(ctx, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete index: %s", err))
+		return
+	}
 	resp.State.RemoveResource(ctx)
 }
+
+// ImportState imports an index by ID.
+func (r *CoveoIndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
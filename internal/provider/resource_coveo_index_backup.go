@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+var _ resource.ResourceWithImportState = &CoveoIndexBackupResource{}
+
+// defaultIndexBackupTimeout is used when backup_timeout is not set.
+const defaultIndexBackupTimeout = "1h"
+
+// waitForIndexBackup polls the backup until it completes, and fails as soon
+// as the Platform API reports it failed.
+func waitForIndexBackup(ctx context.Context, client *CoveoClient, id string, timeout time.Duration) (*coveo.IndexBackup, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(indexPollInterval)
+	defer ticker.Stop()
+
+	for {
+		backup, err := client.Indexes.GetBackup(ctx, id)
+		if err != nil && ctx.Err() == nil {
+			return nil, fmt.Errorf("reading index backup: %w", err)
+		}
+		if err == nil {
+			switch backup.Status {
+			case coveo.IndexBackupStatusCompleted:
+				return backup, nil
+			case coveo.IndexBackupStatusFailed:
+				return nil, fmt.Errorf("index backup %s failed", id)
+			}
+			tflog.Debug(ctx, "Index backup not completed yet", map[string]interface{}{"backup_id": id, "status": backup.Status})
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("index backup %s did not complete within %s", id, timeout)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CoveoIndexBackupResource takes a backup of an index. Destroying the
+// resource deletes the backup.
+type CoveoIndexBackupResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoIndexBackupResource(client *CoveoClient) resource.Resource {
+	return &CoveoIndexBackupResource{client: client}
+}
+
+type coveoIndexBackupResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	IndexID       types.String `tfsdk:"index_id"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	Status        types.String `tfsdk:"status"`
+	CreatedDate   types.String `tfsdk:"created_date"`
+	SizeInBytes   types.Int64  `tfsdk:"size_in_bytes"`
+	BackupTimeout types.String `tfsdk:"backup_timeout"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoIndexBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_index_backup"
+}
+
+// Schema defines the schema for the index backup resource.
+func (r *CoveoIndexBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Takes a backup of a Coveo index and waits for it to complete. Destroying the resource deletes the backup.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The backup ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the index to back up.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that take a new backup when they change, such as the version of a schema about to be migrated.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The backup status, such as COMPLETED.",
+			},
+			"created_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the backup was taken, in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_in_bytes": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The size of the backup.",
+			},
+			"backup_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultIndexBackupTimeout),
+				Description: "How long to wait for the backup to complete, as a Go duration such as 1h.",
+			},
+		},
+	}
+}
+
+func (m *coveoIndexBackupResourceModel) fromAPI(backup *coveo.IndexBackup) {
+	m.ID = types.StringValue(backup.ID)
+	if backup.IndexID != "" {
+		m.IndexID = types.StringValue(backup.IndexID)
+	}
+	m.Status = types.StringValue(backup.Status)
+	m.CreatedDate = types.StringValue(formatEpochMillis(backup.CreatedDate))
+	m.SizeInBytes = types.Int64Value(backup.SizeInBytes)
+}
+
+// Create starts the backup and waits for it to complete.
+func (r *CoveoIndexBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoIndexBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, err := time.ParseDuration(plan.BackupTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("backup_timeout must be a duration such as 1h: %s", err))
+		return
+	}
+
+	id, err := r.client.Indexes.CreateBackup(ctx, plan.IndexID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to back up index: %s", err))
+		return
+	}
+	// Track the backup even if it does not complete, so that it is replaced
+	// on the next apply.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	backup, err := waitForIndexBackup(ctx, r.client, id, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Backup Error", err.Error())
+		return
+	}
+	plan.fromAPI(backup)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the backup status.
+func (r *CoveoIndexBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoIndexBackupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backup, err := r.client.Indexes.GetBackup(ctx, state.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read index backup: %s", err))
+		return
+	}
+	state.fromAPI(backup)
+	if state.BackupTimeout.IsNull() {
+		state.BackupTimeout = types.StringValue(defaultIndexBackupTimeout)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only stores the new backup_timeout; every other change replaces the
+// backup.
+func (r *CoveoIndexBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state coveoIndexBackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.BackupTimeout = plan.BackupTimeout
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the backup.
+func (r *CoveoIndexBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoIndexBackupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Indexes.DeleteBackup(ctx, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete index backup: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a backup by ID.
+func (r *CoveoIndexBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+// waitForIndexRestored polls the index until it is online with a restore
+// more recent than lastRestoreDate, its value before the restore was
+// requested. Comparing restore dates rather than watching the index go
+// offline and back online also catches restores completing between two polls.
+func waitForIndexRestored(ctx context.Context, client *CoveoClient, id string, lastRestoreDate int64, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(indexPollInterval)
+	defer ticker.Stop()
+
+	for {
+		index, err := client.Indexes.Get(ctx, id)
+		if err != nil && ctx.Err() == nil {
+			return fmt.Errorf("reading index: %w", err)
+		}
+		if err == nil {
+			if index.Online && index.LastRestoreDate > lastRestoreDate {
+				return nil
+			}
+			if index.Status == coveo.IndexStatusFailed {
+				return fmt.Errorf("restore of index %s failed", id)
+			}
+			tflog.Debug(ctx, "Index restore not completed yet", map[string]interface{}{"index_id": id, "status": index.Status})
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("restore of index %s did not complete within %s", id, timeout)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CoveoIndexRestoreResource restores an index from a backup when it is
+// created. It records the restore in the state; destroying it leaves the
+// index untouched.
+type CoveoIndexRestoreResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoIndexRestoreResource(client *CoveoClient) resource.Resource {
+	return &CoveoIndexRestoreResource{client: client}
+}
+
+type coveoIndexRestoreResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	IndexID       types.String `tfsdk:"index_id"`
+	BackupID      types.String `tfsdk:"backup_id"`
+	Triggers      types.Map    `tfsdk:"triggers"`
+	RestoredDate  types.String `tfsdk:"restored_date"`
+	OnlineTimeout types.String `tfsdk:"online_timeout"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoIndexRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_index_restore"
+}
+
+// Schema defines the schema for the index restore resource.
+func (r *CoveoIndexRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores a Coveo index from a backup and waits for it to be back online. " +
+			"The restore runs when the resource is created or replaced; destroying the resource leaves the index untouched.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The restore ID, in the form index_id/backup_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"index_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the index to restore. Its current content is replaced.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the backup to restore, such as the id of a coveo_index_backup.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that restore the backup again when they change.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"restored_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the index was back online after the restore, in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"online_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultIndexOnlineTimeout),
+				Description: "How long to wait for the index to be back online, as a Go duration such as 45m.",
+			},
+		},
+	}
+}
+
+// Create restores the backup, then waits for the index to be back online
+// with the restore completed.
+func (r *CoveoIndexRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoIndexRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, err := time.ParseDuration(plan.OnlineTimeout.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("online_timeout must be a duration such as 45m: %s", err))
+		return
+	}
+
+	indexID, backupID := plan.IndexID.ValueString(), plan.BackupID.ValueString()
+	index, err := r.client.Indexes.Get(ctx, indexID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read index: %s", err))
+		return
+	}
+	if err := r.client.Indexes.RestoreBackup(ctx, indexID, backupID); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to restore index: %s", err))
+		return
+	}
+	if err := waitForIndexRestored(ctx, r.client, indexID, index.LastRestoreDate, timeout); err != nil {
+		resp.Diagnostics.AddError("Restore Error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(indexID + "/" + backupID)
+	plan.RestoredDate = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the restore from the state when its index no longer exists.
+func (r *CoveoIndexRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoIndexRestoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.Indexes.Get(ctx, state.IndexID.ValueString()); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read index: %s", err))
+	}
+}
+
+// Update only stores the new online_timeout; every other change restores the
+// backup again.
+func (r *CoveoIndexRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state coveoIndexRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.OnlineTimeout = plan.OnlineTimeout
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete only removes the restore from the state.
+func (r *CoveoIndexRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
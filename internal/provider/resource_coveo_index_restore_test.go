@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testIndexRestoreCreate creates a restore of backup bk1 into index idx from
+// a server answering successive reads of the index with statuses, and
+// returns the response and the number of reads.
+func testIndexRestoreCreate(t *testing.T, statuses []string) (resource.CreateResponse, int) {
+	indexPollInterval = time.Millisecond
+	t.Cleanup(func() { indexPollInterval = 15 * time.Second })
+
+	ctx := context.Background()
+	var reads int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/rest/organizations/myorg/indexes/idx/backups/bk1/restore":
+			if reads != 1 {
+				t.Errorf("restore requested after %d reads, want 1", reads)
+			}
+		case r.Method == "GET" && r.URL.Path == "/rest/organizations/myorg/indexes/idx":
+			fmt.Fprint(w, statuses[min(reads, len(statuses)-1)])
+			reads++
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	r := &CoveoIndexRestoreResource{client: client}
+	s := testResourceSchema(ctx, r)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":             unknown,
+		"index_id":       str("idx"),
+		"backup_id":      str("bk1"),
+		"restored_date":  unknown,
+		"online_timeout": str("1s"),
+	})}}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	return resp, reads
+}
+
+func TestCoveoIndexRestoreResource_CreateWaitsForRestore(t *testing.T) {
+	statuses := []string{
+		`{"id":"idx","status":"ONLINE","online":true,"lastRestoreDate":100}`,
+		`{"id":"idx","status":"ONLINE","online":true,"lastRestoreDate":100}`,
+		`{"id":"idx","status":"RESTORING","online":false,"lastRestoreDate":100}`,
+		`{"id":"idx","status":"RESTORING","online":false,"lastRestoreDate":100}`,
+		`{"id":"idx","status":"ONLINE","online":true,"lastRestoreDate":200}`,
+	}
+	resp, reads := testIndexRestoreCreate(t, statuses)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	if reads != len(statuses) {
+		t.Errorf("read the index %d times, want %d: the restore must be seen completing", reads, len(statuses))
+	}
+	var id string
+	resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+	if id != "idx/bk1" {
+		t.Errorf("id = %q, want idx/bk1", id)
+	}
+}
+
+func TestCoveoIndexRestoreResource_CreateRestoreBetweenPolls(t *testing.T) {
+	// The index went offline and back online before the first poll.
+	resp, reads := testIndexRestoreCreate(t, []string{
+		`{"id":"idx","status":"ONLINE","online":true}`,
+		`{"id":"idx","status":"ONLINE","online":true,"lastRestoreDate":200}`,
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if reads != 2 {
+		t.Errorf("read the index %d times, want 2", reads)
+	}
+}
+
+func TestCoveoIndexRestoreResource_CreateRestoreFailed(t *testing.T) {
+	resp, _ := testIndexRestoreCreate(t, []string{
+		`{"id":"idx","status":"ONLINE","online":true,"lastRestoreDate":100}`,
+		`{"id":"idx","status":"FAILED","online":false,"lastRestoreDate":100}`,
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a restore error")
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestWaitForIndexOnline(t *testing.T) {
+	indexPollInterval = time.Millisecond
+	t.Cleanup(func() { indexPollInterval = 15 * time.Second })
+
+	var reads int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/indexes/idx" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		reads++
+		if reads < 3 {
+			fmt.Fprint(w, `{"id":"idx","status":"PROVISIONING","online":false}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"idx","status":"ONLINE","online":true,"machineSpec":{"architecture":"T3_MEDIUM","storageSpec":{"numberOfGibibytes":100}}}`)
+	})
+
+	index, err := waitForIndexOnline(context.Background(), client, "idx", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reads != 3 {
+		t.Errorf("read the index %d times, want 3", reads)
+	}
+	if index.MachineSpec == nil || index.MachineSpec.StorageSpec.NumberOfGibibytes != 100 {
+		t.Errorf("unexpected index %+v", index)
+	}
+}
+
+func TestWaitForIndexOnline_Failed(t *testing.T) {
+	indexPollInterval = time.Millisecond
+	t.Cleanup(func() { indexPollInterval = 15 * time.Second })
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"idx","status":"FAILED","online":false}`)
+	})
+
+	_, err := waitForIndexOnline(context.Background(), client, "idx", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("expected provisioning error, got %v", err)
+	}
+}
+
+func TestWaitForIndexOnline_Timeout(t *testing.T) {
+	indexPollInterval = time.Millisecond
+	t.Cleanup(func() { indexPollInterval = 15 * time.Second })
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"idx","status":"PROVISIONING","online":false}`)
+	})
+
+	_, err := waitForIndexOnline(context.Background(), client, "idx", 20*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "not online within") {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+}
+
+func TestWaitForIndexBackup(t *testing.T) {
+	indexPollInterval = time.Millisecond
+	t.Cleanup(func() { indexPollInterval = 15 * time.Second })
+
+	var reads int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/indexes/backups/b1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		reads++
+		if reads < 2 {
+			fmt.Fprint(w, `{"id":"b1","indexId":"idx","status":"IN_PROGRESS"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"b1","indexId":"idx","status":"COMPLETED","sizeInBytes":2048}`)
+	})
+
+	backup, err := waitForIndexBackup(context.Background(), client, "b1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backup.SizeInBytes != 2048 {
+		t.Errorf("unexpected backup %+v", backup)
+	}
+}
+
+func TestWaitForIndexBackup_Failed(t *testing.T) {
+	indexPollInterval = time.Millisecond
+	t.Cleanup(func() { indexPollInterval = 15 * time.Second })
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"b1","status":"FAILED"}`)
+	})
+
+	_, err := waitForIndexBackup(context.Background(), client, "b1", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("expected backup error, got %v", err)
+	}
+}
+
+func TestCoveoIndexResource_CreateSavesPlanOnProvisioningError(t *testing.T) {
+	indexPollInterval = time.Millisecond
+	t.Cleanup(func() { indexPollInterval = 15 * time.Second })
+
+	ctx := context.Background()
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprint(w, `{"id":"idx"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"idx","status":"FAILED","online":false}`)
+	})
+	r := &CoveoIndexResource{client: client}
+	s := testResourceSchema(ctx, r)
+	unknown := func(typ tftypes.Type) tftypes.Value { return tftypes.NewValue(typ, tftypes.UnknownValue) }
+
+	plan := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":                  unknown(tftypes.String),
+		"logical_index":       unknown(tftypes.String),
+		"region":              unknown(tftypes.String),
+		"machine_spec":        tftypes.NewValue(tftypes.String, "T3_MEDIUM"),
+		"storage_gib":         unknown(tftypes.Number),
+		"replicas":            tftypes.NewValue(tftypes.Number, 1),
+		"slices":              tftypes.NewValue(tftypes.Number, 1),
+		"status":              unknown(tftypes.String),
+		"online":              unknown(tftypes.Bool),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, false),
+		"online_timeout":      tftypes.NewValue(tftypes.String, "1m"),
+	})
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: plan}}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a provisioning error")
+	}
+
+	var state coveoIndexResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "idx" || state.DeletionProtection.ValueBool() {
+		t.Errorf("unexpected state %+v, want idx saved with deletion_protection false", state)
+	}
+	if !resp.State.Raw.IsFullyKnown() {
+		t.Errorf("state holds unknown values: %s", resp.State.Raw)
+	}
+}
+
+func TestCoveoIndexResource_ValidateConfigOnlineTimeout(t *testing.T) {
+	ctx := context.Background()
+	r := &CoveoIndexResource{}
+	s := testResourceSchema(ctx, r)
+
+	for timeout, wantErr := range map[string]bool{"1h": false, "an hour": true} {
+		config := tfsdk.Config{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+			"machine_spec":   tftypes.NewValue(tftypes.String, "T3_MEDIUM"),
+			"online_timeout": tftypes.NewValue(tftypes.String, timeout),
+		})}
+
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		if got := resp.Diagnostics.HasError(); got != wantErr {
+			t.Errorf("online_timeout %q: HasError() = %v, want %v: %v", timeout, got, wantErr, resp.Diagnostics)
+		}
+	}
+}
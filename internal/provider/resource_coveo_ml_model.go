@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &CoveoMLModelResource{}
+	_ resource.ResourceWithValidateConfig = &CoveoMLModelResource{}
+)
+
+// mlEngines maps the model types accepted by the provider to Coveo ML engine
+// IDs.
+var mlEngines = map[string]string{
+	"ART": "topclicks",
+	"QS":  "querysuggest",
+	"CR":  "eventrecommendation",
+	"DNE": "facetsense",
+	"PR":  "ecommerce",
+}
+
+// mlEngineType returns the model type of a Coveo ML engine ID.
+func mlEngineType(engineID string) string {
+	for t, id := range mlEngines {
+		if id == engineID {
+			return t
+		}
+	}
+	return engineID
+}
+
+// mlEngineNames returns the sorted model types accepted by the provider.
+func mlEngineNames() []string {
+	names := make([]string, 0, len(mlEngines))
+	for t := range mlEngines {
+		names = append(names, t)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// coveoMLModel is the machine learning model of the Platform API.
+type coveoMLModel struct {
+	ID                  string            `json:"id,omitempty"`
+	EngineID            string            `json:"engineId"`
+	ModelDisplayName    string            `json:"modelDisplayName"`
+	ExportPeriod        string            `json:"exportPeriod"`
+	IntervalTime        int64             `json:"intervalTime"`
+	IntervalUnit        string            `json:"intervalUnit"`
+	CommonFilter        string            `json:"commonFilter,omitempty"`
+	ExportedFields      []string          `json:"exportedFields,omitempty"`
+	ExtraConfig         map[string]string `json:"extraConfig,omitempty"`
+	Status              string            `json:"status,omitempty"`
+	LastModelUpdateTime int64             `json:"lastModelUpdateTime,omitempty"`
+	NextModelUpdateTime int64             `json:"nextModelUpdateTime,omitempty"`
+}
+
+// CoveoMLModelResource manages a Coveo machine learning model.
+type CoveoMLModelResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoMLModelResource(client *CoveoClient) resource.Resource {
+	return &CoveoMLModelResource{client: client}
+}
+
+type coveoMLModelModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Engine         types.String `tfsdk:"engine"`
+	DataPeriod     types.String `tfsdk:"data_period"`
+	IntervalTime   types.Int64  `tfsdk:"interval_time"`
+	IntervalUnit   types.String `tfsdk:"interval_unit"`
+	Filter         types.String `tfsdk:"filter"`
+	ExportedFields types.List   `tfsdk:"exported_fields"`
+	Parameters     types.Map    `tfsdk:"parameters"`
+	Status         types.String `tfsdk:"status"`
+	LastBuildDate  types.String `tfsdk:"last_build_date"`
+	NextBuildDate  types.String `tfsdk:"next_build_date"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoMLModelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_ml_model"
+}
+
+// Schema defines the schema for the ML model resource.
+func (r *CoveoMLModelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo machine learning model.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The model ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The model display name.",
+			},
+			"engine": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The model type: %s.", strings.Join(mlEngineNames(), ", ")),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data_period": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("P3M"),
+				Description: "The period of usage analytics data the model learns from, as an ISO 8601 duration such as P3M.",
+			},
+			"interval_time": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How many interval_unit elapse between two model builds.",
+			},
+			"interval_unit": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The unit of interval_time: DAY, WEEK or MONTH.",
+			},
+			"filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "A filter on the usage analytics events the model learns from, such as originLevel1=='docs'.",
+			},
+			"exported_fields": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The index fields exported to the model.",
+			},
+			"parameters": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Engine-specific parameters, passed to the model as extra configuration.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The model status, such as BUILDING, ONLINE or ERROR.",
+			},
+			"last_build_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the model was last built, in RFC 3339 format.",
+			},
+			"next_build_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the model will next be built, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the engine.
+func (r *CoveoMLModelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoMLModelModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.Engine; !v.IsNull() && !v.IsUnknown() && !oneOf(v.ValueString(), mlEngineNames()) {
+		resp.Diagnostics.AddAttributeError(path.Root("engine"), "Invalid Configuration",
+			fmt.Sprintf("engine must be one of %s, got %q.", strings.Join(mlEngineNames(), ", "), v.ValueString()))
+	}
+}
+
+func (m *coveoMLModelModel) toAPI(ctx context.Context) (coveoMLModel, error) {
+	model := coveoMLModel{
+		EngineID:         mlEngines[m.Engine.ValueString()],
+		ModelDisplayName: m.Name.ValueString(),
+		ExportPeriod:     m.DataPeriod.ValueString(),
+		IntervalTime:     m.IntervalTime.ValueInt64(),
+		IntervalUnit:     m.IntervalUnit.ValueString(),
+		CommonFilter:     m.Filter.ValueString(),
+	}
+	if !m.ExportedFields.IsNull() && !m.ExportedFields.IsUnknown() {
+		if diags := m.ExportedFields.ElementsAs(ctx, &model.ExportedFields, false); diags.HasError() {
+			return model, fmt.Errorf("invalid exported_fields")
+		}
+	}
+	if !m.Parameters.IsNull() && !m.Parameters.IsUnknown() {
+		if diags := m.Parameters.ElementsAs(ctx, &model.ExtraConfig, false); diags.HasError() {
+			return model, fmt.Errorf("invalid parameters")
+		}
+	}
+	return model, nil
+}
+
+// fromAPI sets the model from the model details. An empty exported_fields or
+// parameters is kept empty rather than null when m already holds one, so that
+// a configured empty value reads back as configured.
+func (m *coveoMLModelModel) fromAPI(ctx context.Context, model *coveoMLModel) error {
+	m.ID = types.StringValue(model.ID)
+	m.Name = types.StringValue(model.ModelDisplayName)
+	m.Engine = types.StringValue(mlEngineType(model.EngineID))
+	m.DataPeriod = types.StringValue(model.ExportPeriod)
+	m.IntervalTime = types.Int64Value(model.IntervalTime)
+	m.IntervalUnit = types.StringValue(model.IntervalUnit)
+	m.Filter = types.StringNull()
+	if model.CommonFilter != "" {
+		m.Filter = types.StringValue(model.CommonFilter)
+	}
+	if len(model.ExportedFields) == 0 && (m.ExportedFields.IsNull() || m.ExportedFields.IsUnknown()) {
+		m.ExportedFields = types.ListNull(types.StringType)
+	} else {
+		list, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, model.ExportedFields...))
+		if diags.HasError() {
+			return fmt.Errorf("invalid exported fields in response")
+		}
+		m.ExportedFields = list
+	}
+	if len(model.ExtraConfig) == 0 && (m.Parameters.IsNull() || m.Parameters.IsUnknown()) {
+		m.Parameters = types.MapNull(types.StringType)
+	} else {
+		extra := map[string]string{}
+		for k, v := range model.ExtraConfig {
+			extra[k] = v
+		}
+		params, diags := types.MapValueFrom(ctx, types.StringType, extra)
+		if diags.HasError() {
+			return fmt.Errorf("invalid extra configuration in response")
+		}
+		m.Parameters = params
+	}
+	m.Status = types.StringValue(model.Status)
+	m.LastBuildDate = types.StringValue(formatEpochMillis(model.LastModelUpdateTime))
+	m.NextBuildDate = types.StringValue(formatEpochMillis(model.NextModelUpdateTime))
+	return nil
+}
+
+// read fetches the model details.
+func (r *CoveoMLModelResource) read(ctx context.Context, id string) (*coveoMLModel, error) {
+	body, err := r.client.DoPlatformRequest(ctx, "GET", fmt.Sprintf("machinelearning/models/%s/details", url.PathEscape(id)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var model coveoMLModel
+	if err := json.Unmarshal(body, &model); err != nil {
+		return nil, err
+	}
+	return &model, nil
+}
+
+// Create creates the model. The first build starts asynchronously.
+func (r *CoveoMLModelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoMLModelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	body, err := r.client.DoPlatformRequest(ctx, "POST", "machinelearning/models", model)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create ML model: %s", err))
+		return
+	}
+	var created coveoMLModel
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid model ID.")
+		return
+	}
+
+	details, err := r.read(ctx, created.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read ML model: %s", err))
+		return
+	}
+	if err := plan.fromAPI(ctx, details); err != nil {
+		resp.Diagnostics.AddError("Parse Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the model and its status.
+func (r *CoveoMLModelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoMLModelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	details, err := r.read(ctx, state.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read ML model: %s", err))
+		return
+	}
+	if err := state.fromAPI(ctx, details); err != nil {
+		resp.Diagnostics.AddError("Parse Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update changes the model parameters.
+func (r *CoveoMLModelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoMLModelModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	model.ID = plan.ID.ValueString()
+	_, err = r.client.DoPlatformRequest(ctx, "PUT", "machinelearning/models/"+url.PathEscape(model.ID), model)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update ML model: %s", err))
+		return
+	}
+
+	details, err := r.read(ctx, model.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read ML model: %s", err))
+		return
+	}
+	if err := plan.fromAPI(ctx, details); err != nil {
+		resp.Diagnostics.AddError("Parse Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the model.
+func (r *CoveoMLModelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoMLModelModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", "machinelearning/models/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete ML model: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a model by ID.
+func (r *CoveoMLModelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
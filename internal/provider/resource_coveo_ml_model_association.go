@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoMLModelAssociationResource{}
+
+// coveoMLModelAssociation links a model to a query pipeline.
+type coveoMLModelAssociation struct {
+	ID          string `json:"id,omitempty"`
+	ModelID     string `json:"modelId"`
+	ConditionID string `json:"condition,omitempty"`
+	Position    int64  `json:"position,omitempty"`
+}
+
+// CoveoMLModelAssociationResource associates a machine learning model with a
+// query pipeline.
+type CoveoMLModelAssociationResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoMLModelAssociationResource(client *CoveoClient) resource.Resource {
+	return &CoveoMLModelAssociationResource{client: client}
+}
+
+type coveoMLModelAssociationModel struct {
+	ID            types.String `tfsdk:"id"`
+	AssociationID types.String `tfsdk:"association_id"`
+	PipelineID    types.String `tfsdk:"pipeline_id"`
+	ModelID       types.String `tfsdk:"model_id"`
+	ConditionID   types.String `tfsdk:"condition_id"`
+	Position      types.Int64  `tfsdk:"position"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoMLModelAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_ml_model_association"
+}
+
+// Schema defines the schema for the ML model association resource.
+func (r *CoveoMLModelAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Associates a Coveo machine learning model with a query pipeline.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The resource ID, in the form pipeline_id/association_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"association_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the association within the pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pipeline_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the query pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"model_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the coveo_ml_model to associate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"condition_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the condition restricting when the model applies.",
+			},
+			"position": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The one-based position of the association among the pipeline model associations.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (m *coveoMLModelAssociationModel) endpoint() string {
+	e := fmt.Sprintf("pipelines/%s/ml/model/associations", url.PathEscape(m.PipelineID.ValueString()))
+	if id := m.AssociationID.ValueString(); id != "" {
+		e = fmt.Sprintf("%s/%s", e, url.PathEscape(id))
+	}
+	return e
+}
+
+func (m *coveoMLModelAssociationModel) toAPI() coveoMLModelAssociation {
+	return coveoMLModelAssociation{
+		ModelID:     m.ModelID.ValueString(),
+		ConditionID: m.ConditionID.ValueString(),
+		Position:    m.Position.ValueInt64(),
+	}
+}
+
+func (m *coveoMLModelAssociationModel) fromAPI(a *coveoMLModelAssociation) {
+	m.AssociationID = types.StringValue(a.ID)
+	m.ID = types.StringValue(fmt.Sprintf("%s/%s", m.PipelineID.ValueString(), a.ID))
+	m.ModelID = types.StringValue(a.ModelID)
+	m.ConditionID = types.StringNull()
+	if a.ConditionID != "" {
+		m.ConditionID = types.StringValue(a.ConditionID)
+	}
+	m.Position = types.Int64Value(a.Position)
+}
+
+func (r *CoveoMLModelAssociationResource) read(ctx context.Context, m *coveoMLModelAssociationModel) error {
+	body, err := r.client.DoSearchAdminRequest(ctx, "GET", m.endpoint(), nil, nil)
+	if err != nil {
+		return err
+	}
+	var a coveoMLModelAssociation
+	if err := json.Unmarshal(body, &a); err != nil {
+		return err
+	}
+	m.fromAPI(&a)
+	return nil
+}
+
+// Create associates the model with the pipeline.
+func (r *CoveoMLModelAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoMLModelAssociationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.AssociationID = types.StringNull()
+	body, err := r.client.DoSearchAdminRequest(ctx, "POST", plan.endpoint(), nil, plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to associate ML model: %s", err))
+		return
+	}
+	var created coveoMLModelAssociation
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid association ID.")
+		return
+	}
+
+	plan.AssociationID = types.StringValue(created.ID)
+	if err := r.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read ML model association: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the association.
+func (r *CoveoMLModelAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoMLModelAssociationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read ML model association: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update changes the condition or position of the association.
+func (r *CoveoMLModelAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoMLModelAssociationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	association := plan.toAPI()
+	association.ID = plan.AssociationID.ValueString()
+	if _, err := r.client.DoSearchAdminRequest(ctx, "PUT", plan.endpoint(), nil, association); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update ML model association: %s", err))
+		return
+	}
+
+	if err := r.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read ML model association: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the association. The model itself is kept.
+func (r *CoveoMLModelAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoMLModelAssociationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoSearchAdminRequest(ctx, "DELETE", state.endpoint(), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete ML model association: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports an association from an ID in the form
+// pipeline_id/association_id.
+func (r *CoveoMLModelAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "pipeline_id", "association_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pipeline_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("association_id"), parts[1])...)
+}
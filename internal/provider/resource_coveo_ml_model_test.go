@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoMLModelModel_ToAPI(t *testing.T) {
+	m := coveoMLModelModel{
+		Name:           types.StringValue("docs ART"),
+		Engine:         types.StringValue("ART"),
+		DataPeriod:     types.StringValue("P3M"),
+		IntervalTime:   types.Int64Value(1),
+		IntervalUnit:   types.StringValue("WEEK"),
+		Filter:         types.StringNull(),
+		ExportedFields: types.ListNull(types.StringType),
+		Parameters:     types.MapValueMust(types.StringType, map[string]attr.Value{"maxRecommendations": types.StringValue("5")}),
+	}
+
+	model, err := m.toAPI(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := json.Marshal(model)
+	want := `{"engineId":"topclicks","modelDisplayName":"docs ART","exportPeriod":"P3M","intervalTime":1,"intervalUnit":"WEEK","extraConfig":{"maxRecommendations":"5"}}`
+	if string(body) != want {
+		t.Errorf("request body = %s, want %s", body, want)
+	}
+}
+
+func TestCoveoMLModelResource_ValidateConfigEngine(t *testing.T) {
+	ctx := context.Background()
+	r := &CoveoMLModelResource{}
+	s := testResourceSchema(ctx, r)
+
+	for engine, wantErr := range map[string]bool{"ART": false, "XYZ": true} {
+		config := tfsdk.Config{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+			"name":   tftypes.NewValue(tftypes.String, "docs"),
+			"engine": tftypes.NewValue(tftypes.String, engine),
+		})}
+
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		if got := resp.Diagnostics.HasError(); got != wantErr {
+			t.Errorf("engine %q: HasError() = %v, want %v: %v", engine, got, wantErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestCoveoMLModelModel_FromAPIKeepsEmptyCollections(t *testing.T) {
+	ctx := context.Background()
+	m := coveoMLModelModel{
+		ExportedFields: types.ListValueMust(types.StringType, []attr.Value{}),
+		Parameters:     types.MapNull(types.StringType),
+	}
+	if err := m.fromAPI(ctx, &coveoMLModel{ID: "m1", EngineID: "topclicks"}); err != nil {
+		t.Fatal(err)
+	}
+	if m.ExportedFields.IsNull() || len(m.ExportedFields.Elements()) != 0 {
+		t.Errorf("exported_fields = %s, want an empty list", m.ExportedFields)
+	}
+	if !m.Parameters.IsNull() {
+		t.Errorf("parameters = %s, want null", m.Parameters)
+	}
+
+	m.ExportedFields = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("@source")})
+	if err := m.fromAPI(ctx, &coveoMLModel{ID: "m1", EngineID: "topclicks"}); err != nil {
+		t.Fatal(err)
+	}
+	if m.ExportedFields.IsNull() || len(m.ExportedFields.Elements()) != 0 {
+		t.Errorf("exported_fields removed in Coveo = %s, want an empty list", m.ExportedFields)
+	}
+}
+
+func TestMLEngineType(t *testing.T) {
+	for engine, id := range mlEngines {
+		if got := mlEngineType(id); got != engine {
+			t.Errorf("mlEngineType(%q) = %q, want %q", id, got, engine)
+		}
+	}
+}
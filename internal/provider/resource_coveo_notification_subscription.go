@@ -0,0 +1,375 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &CoveoNotificationSubscriptionResource{}
+	_ resource.ResourceWithValidateConfig = &CoveoNotificationSubscriptionResource{}
+)
+
+const (
+	notificationTypeEmail   = "EMAIL"
+	notificationTypeWebhook = "WEBHOOK"
+)
+
+// notificationEventTypes lists the events a subscription can be notified of.
+var notificationEventTypes = []string{
+	"SOURCE_UPDATE_FAILURE",
+	"ACTIVITY",
+	"MONITORING",
+}
+
+// notificationFrequencies lists how often notifications can be sent.
+var notificationFrequencies = []string{
+	"LIVE",
+	"HOURLY",
+	"DAILY",
+	"WEEKLY",
+}
+
+// coveoNotificationSubscription is the notification subscription model of the
+// Platform API.
+type coveoNotificationSubscription struct {
+	ID                string                        `json:"id,omitempty"`
+	Name              string                        `json:"name"`
+	Type              string                        `json:"type"`
+	EventType         string                        `json:"eventType"`
+	Frequency         string                        `json:"frequency"`
+	Parameters        map[string]string             `json:"parameters"`
+	EmailParameters   *coveoNotificationEmailParams `json:"emailParameters,omitempty"`
+	WebhookParameters *coveoNotificationWebhook     `json:"webhookParameters,omitempty"`
+}
+
+type coveoNotificationEmailParams struct {
+	Recipients []string `json:"recipients"`
+}
+
+type coveoNotificationWebhook struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers"`
+}
+
+// CoveoNotificationSubscriptionResource manages an email or webhook
+// notification subscription.
+type CoveoNotificationSubscriptionResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoNotificationSubscriptionResource(client *CoveoClient) resource.Resource {
+	return &CoveoNotificationSubscriptionResource{client: client}
+}
+
+type coveoNotificationSubscriptionModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	EventType      types.String `tfsdk:"event_type"`
+	Frequency      types.String `tfsdk:"frequency"`
+	Filters        types.Map    `tfsdk:"filters"`
+	Recipients     types.Set    `tfsdk:"recipients"`
+	WebhookURL     types.String `tfsdk:"webhook_url"`
+	WebhookHeaders types.Map    `tfsdk:"webhook_headers"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoNotificationSubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_notification_subscription"
+}
+
+// Schema defines the schema for the notification subscription resource.
+func (r *CoveoNotificationSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo notification subscription, sent by email or to a webhook.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subscription ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The subscription name.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "How notifications are delivered: EMAIL or WEBHOOK.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"event_type": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The events to be notified of: %s.", strings.Join(notificationEventTypes, ", ")),
+			},
+			"frequency": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("LIVE"),
+				Description: fmt.Sprintf("How often notifications are sent: %s. Defaults to LIVE.", strings.Join(notificationFrequencies, ", ")),
+			},
+			"filters": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Filters restricting the notified events, such as sourceId or operationType.",
+			},
+			"recipients": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The emails notified. Required when type is EMAIL.",
+			},
+			"webhook_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The URL notifications are posted to. Required when type is WEBHOOK.",
+			},
+			"webhook_headers": schema.MapAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "HTTP headers sent with each webhook call, such as an Authorization header.",
+			},
+		},
+	}
+}
+
+func oneOf(value string, allowed []string) bool {
+	for _, a := range allowed {
+		if value == a {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateConfig checks the enumerated values and the delivery settings
+// required by the subscription type.
+func (r *CoveoNotificationSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoNotificationSubscriptionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.EventType; !v.IsNull() && !v.IsUnknown() && !oneOf(v.ValueString(), notificationEventTypes) {
+		resp.Diagnostics.AddAttributeError(path.Root("event_type"), "Invalid Configuration",
+			fmt.Sprintf("event_type must be one of %s, got %q.", strings.Join(notificationEventTypes, ", "), v.ValueString()))
+	}
+	if v := config.Frequency; !v.IsNull() && !v.IsUnknown() && !oneOf(v.ValueString(), notificationFrequencies) {
+		resp.Diagnostics.AddAttributeError(path.Root("frequency"), "Invalid Configuration",
+			fmt.Sprintf("frequency must be one of %s, got %q.", strings.Join(notificationFrequencies, ", "), v.ValueString()))
+	}
+
+	if config.Type.IsUnknown() {
+		return
+	}
+	switch config.Type.ValueString() {
+	case notificationTypeEmail:
+		if config.Recipients.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("recipients"), "Invalid Configuration", "recipients must be set when type is EMAIL.")
+		}
+		if !config.WebhookURL.IsNull() || !config.WebhookHeaders.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("webhook_url"), "Invalid Configuration", "webhook_url and webhook_headers can only be set when type is WEBHOOK.")
+		}
+	case notificationTypeWebhook:
+		if config.WebhookURL.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("webhook_url"), "Invalid Configuration", "webhook_url must be set when type is WEBHOOK.")
+		}
+		if !config.Recipients.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("recipients"), "Invalid Configuration", "recipients can only be set when type is EMAIL.")
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Configuration",
+			fmt.Sprintf("type must be EMAIL or WEBHOOK, got %q.", config.Type.ValueString()))
+	}
+}
+
+func (m *coveoNotificationSubscriptionModel) toAPI(ctx context.Context) (coveoNotificationSubscription, error) {
+	sub := coveoNotificationSubscription{
+		Name:       m.Name.ValueString(),
+		Type:       m.Type.ValueString(),
+		EventType:  m.EventType.ValueString(),
+		Frequency:  m.Frequency.ValueString(),
+		Parameters: map[string]string{},
+	}
+	if diags := m.Filters.ElementsAs(ctx, &sub.Parameters, false); diags.HasError() {
+		return sub, fmt.Errorf("invalid filters")
+	}
+
+	switch sub.Type {
+	case notificationTypeEmail:
+		sub.EmailParameters = &coveoNotificationEmailParams{Recipients: []string{}}
+		if diags := m.Recipients.ElementsAs(ctx, &sub.EmailParameters.Recipients, false); diags.HasError() {
+			return sub, fmt.Errorf("invalid recipients")
+		}
+	case notificationTypeWebhook:
+		sub.WebhookParameters = &coveoNotificationWebhook{
+			URL:     m.WebhookURL.ValueString(),
+			Headers: map[string]string{},
+		}
+		if diags := m.WebhookHeaders.ElementsAs(ctx, &sub.WebhookParameters.Headers, false); diags.HasError() {
+			return sub, fmt.Errorf("invalid webhook_headers")
+		}
+	}
+	return sub, nil
+}
+
+// stringMapOrNull returns a map of values. An empty values is null, so an
+// omitted attribute doesn't diff, unless prior is an empty map, so a
+// configured empty map doesn't either.
+func stringMapOrNull(ctx context.Context, prior types.Map, values map[string]string) (types.Map, error) {
+	if len(values) == 0 {
+		if prior.IsNull() || prior.IsUnknown() {
+			return types.MapNull(types.StringType), nil
+		}
+		values = map[string]string{}
+	}
+	m, diags := types.MapValueFrom(ctx, types.StringType, values)
+	if diags.HasError() {
+		return m, fmt.Errorf("invalid map values")
+	}
+	return m, nil
+}
+
+func (r *CoveoNotificationSubscriptionResource) read(ctx context.Context, m *coveoNotificationSubscriptionModel) error {
+	body, err := r.client.DoPlatformRequest(ctx, "GET", "subscriptions/"+url.PathEscape(m.ID.ValueString()), nil)
+	if err != nil {
+		return err
+	}
+	var sub coveoNotificationSubscription
+	if err := json.Unmarshal(body, &sub); err != nil {
+		return err
+	}
+
+	m.Name = types.StringValue(sub.Name)
+	m.Type = types.StringValue(sub.Type)
+	m.EventType = types.StringValue(sub.EventType)
+	m.Frequency = types.StringValue(sub.Frequency)
+	if m.Filters, err = stringMapOrNull(ctx, m.Filters, sub.Parameters); err != nil {
+		return err
+	}
+	recipients, headers := m.Recipients, m.WebhookHeaders
+	m.Recipients = types.SetNull(types.StringType)
+	if sub.EmailParameters != nil {
+		if m.Recipients, err = stringSetOrNull(ctx, recipients, sub.EmailParameters.Recipients); err != nil {
+			return err
+		}
+	}
+	m.WebhookURL = types.StringNull()
+	m.WebhookHeaders = types.MapNull(types.StringType)
+	if sub.WebhookParameters != nil {
+		m.WebhookURL = types.StringValue(sub.WebhookParameters.URL)
+		if m.WebhookHeaders, err = stringMapOrNull(ctx, headers, sub.WebhookParameters.Headers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Create creates the subscription.
+func (r *CoveoNotificationSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoNotificationSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sub, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	body, err := r.client.DoPlatformRequest(ctx, "POST", "subscriptions", sub)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create notification subscription: %s", err))
+		return
+	}
+	var created coveoNotificationSubscription
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid subscription ID.")
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the subscription.
+func (r *CoveoNotificationSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoNotificationSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read notification subscription: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the subscription.
+func (r *CoveoNotificationSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoNotificationSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sub, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	sub.ID = plan.ID.ValueString()
+	if _, err := r.client.DoPlatformRequest(ctx, "PUT", "subscriptions/"+url.PathEscape(sub.ID), sub); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update notification subscription: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the subscription.
+func (r *CoveoNotificationSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoNotificationSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", "subscriptions/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete notification subscription: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a subscription by ID.
+func (r *CoveoNotificationSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNotificationSubscriptionValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &CoveoNotificationSubscriptionResource{}
+	s := testResourceSchema(ctx, r)
+
+	config := func(values map[string]tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{Schema: s, Raw: testObjectValue(ctx, s, values)}
+	}
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+	recipients := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{str("ops@example.com")})
+
+	cases := map[string]struct {
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		"email": {
+			values:  map[string]tftypes.Value{"name": str("n"), "type": str("EMAIL"), "event_type": str("MONITORING"), "recipients": recipients},
+			wantErr: false,
+		},
+		"webhook without url": {
+			values:  map[string]tftypes.Value{"name": str("n"), "type": str("WEBHOOK"), "event_type": str("ACTIVITY")},
+			wantErr: true,
+		},
+		"unknown event type": {
+			values:  map[string]tftypes.Value{"name": str("n"), "type": str("EMAIL"), "event_type": str("SOURCE_FAILED"), "recipients": recipients},
+			wantErr: true,
+		},
+		"unknown frequency": {
+			values:  map[string]tftypes.Value{"name": str("n"), "type": str("EMAIL"), "event_type": str("ACTIVITY"), "frequency": str("MONTHLY"), "recipients": recipients},
+			wantErr: true,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config(c.values)}, &resp)
+			if got := resp.Diagnostics.HasError(); got != c.wantErr {
+				t.Errorf("HasError() = %v, want %v: %v", got, c.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCoveoNotificationSubscriptionResource_ReadKeepsEmptyMaps(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/subscriptions/s1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id":"s1","name":"n","type":"WEBHOOK","eventType":"ACTIVITY","frequency":"LIVE","parameters":{},"webhookParameters":{"url":"https://hooks.example.com","headers":{}}}`)
+	})
+	r := &CoveoNotificationSubscriptionResource{client: client}
+
+	empty := types.MapValueMust(types.StringType, map[string]attr.Value{})
+	m := coveoNotificationSubscriptionModel{ID: types.StringValue("s1"), Filters: empty, WebhookHeaders: types.MapNull(types.StringType)}
+	if err := r.read(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Filters.IsNull() || len(m.Filters.Elements()) != 0 {
+		t.Errorf("filters = %s, want an empty map", m.Filters)
+	}
+	if !m.WebhookHeaders.IsNull() {
+		t.Errorf("webhook_headers = %s, want null", m.WebhookHeaders)
+	}
+
+	m.WebhookHeaders = empty
+	if err := r.read(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.WebhookHeaders.IsNull() || len(m.WebhookHeaders.Elements()) != 0 {
+		t.Errorf("webhook_headers = %s, want an empty map", m.WebhookHeaders)
+	}
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoOrganizationSettingsResource{}
+
+// CoveoOrganizationSettingsResource manages the mutable settings of the
+// configured organization. The organization itself is never created or
+// deleted: destroying the resource only removes it from state.
+type CoveoOrganizationSettingsResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoOrganizationSettingsResource(client *CoveoClient) resource.Resource {
+	return &CoveoOrganizationSettingsResource{client: client}
+}
+
+type coveoOrganizationSettingsModel struct {
+	ID             types.String `tfsdk:"id"`
+	DisplayName    types.String `tfsdk:"display_name"`
+	SessionTimeout types.Int64  `tfsdk:"session_timeout"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoOrganizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_organization_settings"
+}
+
+// Schema defines the schema for the organization settings resource.
+func (r *CoveoOrganizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of the Coveo organization the provider is configured for.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The organization ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The organization display name.",
+			},
+			"session_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The session timeout of the organization, in minutes.",
+			},
+		},
+	}
+}
+
+// apply merges the planned settings into the current organization model and
+// saves it, so that settings not managed here are left untouched.
+func (r *CoveoOrganizationSettingsResource) apply(ctx context.Context, plan *coveoOrganizationSettingsModel) error {
+	body, err := r.client.DoPlatformRequest(ctx, "GET", "", nil)
+	if err != nil {
+		return err
+	}
+	var org map[string]interface{}
+	if err := json.Unmarshal(body, &org); err != nil {
+		return err
+	}
+
+	org["displayName"] = plan.DisplayName.ValueString()
+	if !plan.SessionTimeout.IsNull() && !plan.SessionTimeout.IsUnknown() {
+		org["sessionTimeout"] = plan.SessionTimeout.ValueInt64()
+	}
+
+	if _, err := r.client.DoPlatformRequest(ctx, "PUT", "", org); err != nil {
+		return err
+	}
+	return r.refresh(ctx, plan)
+}
+
+// refresh reads the organization back into the model. A session timeout the
+// API omits keeps its planned or prior value.
+func (r *CoveoOrganizationSettingsResource) refresh(ctx context.Context, model *coveoOrganizationSettingsModel) error {
+	org, err := readOrganization(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	model.ID = types.StringValue(r.client.OrganizationID)
+	model.DisplayName = types.StringValue(org.DisplayName)
+	switch {
+	case org.SessionTimeout != nil:
+		model.SessionTimeout = types.Int64Value(*org.SessionTimeout)
+	case model.SessionTimeout.IsUnknown():
+		model.SessionTimeout = types.Int64Null()
+	}
+	return nil
+}
+
+// Create adopts the organization and applies the configured settings.
+func (r *CoveoOrganizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoOrganizationSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update organization settings: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the settings from the organization.
+func (r *CoveoOrganizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoOrganizationSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ID.ValueString() != r.client.OrganizationID {
+		resp.Diagnostics.AddError(
+			"Organization Mismatch",
+			fmt.Sprintf("The settings in state belong to organization %q but the provider is configured for %q.", state.ID.ValueString(), r.client.OrganizationID),
+		)
+		return
+	}
+
+	if err := r.refresh(ctx, &state); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read organization settings: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the changed settings.
+func (r *CoveoOrganizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoOrganizationSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.apply(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update organization settings: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only forgets the settings; the organization keeps its current values.
+func (r *CoveoOrganizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the settings by organization ID.
+func (r *CoveoOrganizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
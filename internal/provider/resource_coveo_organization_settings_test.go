@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCoveoOrganizationSettingsResource_RefreshSessionTimeout(t *testing.T) {
+	var org string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, org)
+	})
+	r := &CoveoOrganizationSettingsResource{client: client}
+
+	cases := map[string]struct {
+		org   string
+		prior types.Int64
+		want  types.Int64
+	}{
+		"returned":         {org: `{"displayName":"Acme","sessionTimeout":60}`, prior: types.Int64Value(30), want: types.Int64Value(60)},
+		"omitted":          {org: `{"displayName":"Acme"}`, prior: types.Int64Value(30), want: types.Int64Value(30)},
+		"omitted, unknown": {org: `{"displayName":"Acme"}`, prior: types.Int64Unknown(), want: types.Int64Null()},
+		"omitted, not set": {org: `{"displayName":"Acme"}`, prior: types.Int64Null(), want: types.Int64Null()},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			org = c.org
+			m := coveoOrganizationSettingsModel{SessionTimeout: c.prior}
+			if err := r.refresh(context.Background(), &m); err != nil {
+				t.Fatal(err)
+			}
+			if !m.SessionTimeout.Equal(c.want) {
+				t.Errorf("session_timeout = %s, want %s", m.SessionTimeout, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoSearchHubResource{}
+
+// coveoSearchHub is the search hub model of the Platform API.
+type coveoSearchHub struct {
+	ID             string   `json:"id,omitempty"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	PipelineID     string   `json:"pipelineId,omitempty"`
+	AllowedOrigins []string `json:"allowedOrigins"`
+}
+
+// CoveoSearchHubResource manages a search hub.
+type CoveoSearchHubResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSearchHubResource(client *CoveoClient) resource.Resource {
+	return &CoveoSearchHubResource{client: client}
+}
+
+type coveoSearchHubModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	PipelineID     types.String `tfsdk:"pipeline_id"`
+	AllowedOrigins types.Set    `tfsdk:"allowed_origins"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoSearchHubResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_search_hub"
+}
+
+// Schema defines the schema for the search hub resource.
+func (r *CoveoSearchHubResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo search hub, which identifies a search interface and routes its queries.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The search hub ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The search hub name, sent as searchHub by the search interface.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The search hub description.",
+			},
+			"pipeline_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the query pipeline that handles the queries of the search hub.",
+			},
+			"allowed_origins": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The origins allowed to query through the search hub, such as https://www.example.com.",
+			},
+		},
+	}
+}
+
+func (m *coveoSearchHubModel) toAPI(ctx context.Context) (coveoSearchHub, error) {
+	hub := coveoSearchHub{
+		Name:           m.Name.ValueString(),
+		Description:    m.Description.ValueString(),
+		PipelineID:     m.PipelineID.ValueString(),
+		AllowedOrigins: []string{},
+	}
+	if !m.AllowedOrigins.IsNull() {
+		if diags := m.AllowedOrigins.ElementsAs(ctx, &hub.AllowedOrigins, false); diags.HasError() {
+			return hub, fmt.Errorf("invalid allowed_origins")
+		}
+	}
+	return hub, nil
+}
+
+func (r *CoveoSearchHubResource) read(ctx context.Context, m *coveoSearchHubModel) error {
+	body, err := r.client.DoPlatformRequest(ctx, "GET", "searchhubs/"+url.PathEscape(m.ID.ValueString()), nil)
+	if err != nil {
+		return err
+	}
+	var hub coveoSearchHub
+	if err := json.Unmarshal(body, &hub); err != nil {
+		return err
+	}
+	m.Name = types.StringValue(hub.Name)
+	m.Description = types.StringValue(hub.Description)
+	m.PipelineID = types.StringNull()
+	if hub.PipelineID != "" {
+		m.PipelineID = types.StringValue(hub.PipelineID)
+	}
+	if m.AllowedOrigins, err = stringSetOrNull(ctx, m.AllowedOrigins, hub.AllowedOrigins); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Create creates the search hub.
+func (r *CoveoSearchHubResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoSearchHubModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hub, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	body, err := r.client.DoPlatformRequest(ctx, "POST", "searchhubs", hub)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create search hub: %s", err))
+		return
+	}
+	var created coveoSearchHub
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid search hub ID.")
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	if err := r.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read search hub: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the search hub.
+func (r *CoveoSearchHubResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoSearchHubModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read search hub: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the search hub.
+func (r *CoveoSearchHubResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoSearchHubModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hub, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	hub.ID = plan.ID.ValueString()
+	if _, err := r.client.DoPlatformRequest(ctx, "PUT", "searchhubs/"+url.PathEscape(hub.ID), hub); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update search hub: %s", err))
+		return
+	}
+	if err := r.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read search hub: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the search hub.
+func (r *CoveoSearchHubResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoSearchHubModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", "searchhubs/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete search hub: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a search hub by ID.
+func (r *CoveoSearchHubResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
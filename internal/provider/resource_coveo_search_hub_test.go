@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoSearchHubModel_ToAPI(t *testing.T) {
+	m := coveoSearchHubModel{
+		Name:           types.StringValue("support"),
+		Description:    types.StringValue(""),
+		PipelineID:     types.StringNull(),
+		AllowedOrigins: types.SetNull(types.StringType),
+	}
+	hub, err := m.toAPI(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := json.Marshal(hub)
+	want := `{"name":"support","description":"","allowedOrigins":[]}`
+	if string(body) != want {
+		t.Errorf("request body = %s, want %s", body, want)
+	}
+}
+
+func TestCoveoSearchHubResource_Read(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/searchhubs/h1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id":"h1","name":"support","description":"Support portal","pipelineId":"p1","allowedOrigins":["https://www.example.com"]}`)
+	})
+	r := &CoveoSearchHubResource{client: client}
+
+	m := coveoSearchHubModel{ID: types.StringValue("h1")}
+	if err := r.read(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Description.ValueString() != "Support portal" || m.PipelineID.ValueString() != "p1" || len(m.AllowedOrigins.Elements()) != 1 {
+		t.Errorf("unexpected model %+v", m)
+	}
+}
+
+func TestCoveoSearchHubResource_UpdateReadsBack(t *testing.T) {
+	ctx := context.Background()
+	var put bool
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/searchhubs/h1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		switch r.Method {
+		case "PUT":
+			put = true
+		case "GET":
+			if !put {
+				t.Error("search hub read before it was updated")
+			}
+			fmt.Fprint(w, `{"id":"h1","name":"support","description":"Set by Coveo","pipelineId":"p1","allowedOrigins":["https://www.example.com"]}`)
+		}
+	})
+	r := &CoveoSearchHubResource{client: client}
+	s := testResourceSchema(ctx, r)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	origins := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{str("https://www.example.com")})
+
+	plan := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":              str("h1"),
+		"name":            str("support"),
+		"description":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"pipeline_id":     str("p1"),
+		"allowed_origins": origins,
+	})
+	req := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: s, Raw: plan}, State: tfsdk.State{Schema: s, Raw: plan}}
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: plan}}
+	r.Update(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var got coveoSearchHubModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.Description.ValueString() != "Set by Coveo" {
+		t.Errorf("description = %s, want the one read back from Coveo", got.Description)
+	}
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState = &CoveoSearchPageResource{}
+	_ resource.ResourceWithModifyPlan  = &CoveoSearchPageResource{}
+)
+
+// coveoPageAsset is an inline script or stylesheet of a hosted page.
+type coveoPageAsset struct {
+	InlineContent string `json:"inlineContent"`
+}
+
+// coveoSearchPage is the hosted search page model of the Platform API.
+type coveoSearchPage struct {
+	ID          string           `json:"id,omitempty"`
+	Name        string           `json:"name"`
+	Title       string           `json:"title"`
+	HTML        string           `json:"html"`
+	JavaScript  []coveoPageAsset `json:"javascript"`
+	CSS         []coveoPageAsset `json:"css"`
+	AccessLevel string           `json:"accessLevel"`
+}
+
+// CoveoSearchPageResource manages a hosted search page.
+type CoveoSearchPageResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSearchPageResource(client *CoveoClient) resource.Resource {
+	return &CoveoSearchPageResource{client: client}
+}
+
+type coveoSearchPageModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Title          types.String `tfsdk:"title"`
+	HTML           types.String `tfsdk:"html"`
+	HTMLFile       types.String `tfsdk:"html_file"`
+	JavaScript     types.String `tfsdk:"javascript"`
+	JavaScriptFile types.String `tfsdk:"javascript_file"`
+	CSS            types.String `tfsdk:"css"`
+	CSSFile        types.String `tfsdk:"css_file"`
+	Access         types.String `tfsdk:"access"`
+}
+
+// searchPageContents lists the content attributes that may be given inline or
+// from a file.
+var searchPageContents = []string{"html", "javascript", "css"}
+
+// Metadata sets the resource type name.
+func (r *CoveoSearchPageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_search_page"
+}
+
+// Schema defines the schema for the search page resource.
+func (r *CoveoSearchPageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo hosted search page.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The page ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The page name.",
+			},
+			"title": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The title of the page in the browser.",
+			},
+			"html": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The HTML of the page. Exactly one of html or html_file must be set.",
+			},
+			"html_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file holding the HTML of the page.",
+			},
+			"javascript": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Inline JavaScript loaded by the page. At most one of javascript or javascript_file may be set.",
+			},
+			"javascript_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file holding the JavaScript loaded by the page.",
+			},
+			"css": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Inline CSS loaded by the page. At most one of css or css_file may be set.",
+			},
+			"css_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file holding the CSS loaded by the page.",
+			},
+			"access": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("PRIVATE"),
+				Description: "Who can open the page: PRIVATE for members of the organization, or PUBLIC for anyone. Defaults to PRIVATE.",
+			},
+		},
+	}
+}
+
+// ModifyPlan loads the *_file attributes into their content attributes so
+// that changes to the files show up in the plan.
+func (r *CoveoSearchPageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	for _, name := range searchPageContents {
+		var content, file types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &content)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name+"_file"), &file)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(loadContentFile(ctx, resp, name, content, file)...)
+	}
+}
+
+func loadContentFile(ctx context.Context, resp *resource.ModifyPlanResponse, name string, content, file types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if content.IsUnknown() || file.IsUnknown() {
+		return diags
+	}
+	if !content.IsNull() && !file.IsNull() {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("Only one of %s or %s_file may be set.", name, name))
+		return diags
+	}
+	if name == "html" && content.IsNull() && file.IsNull() {
+		diags.AddError("Invalid Configuration", "Exactly one of html or html_file must be set.")
+		return diags
+	}
+	if file.IsNull() {
+		if content.IsNull() {
+			// Nothing configured: plan an empty value rather than keeping
+			// content loaded from a file that was removed.
+			diags.Append(resp.Plan.SetAttribute(ctx, path.Root(name), "")...)
+		}
+		return diags
+	}
+
+	b, err := os.ReadFile(file.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(name+"_file"), "Invalid Configuration", fmt.Sprintf("Could not read %s_file: %s", name, err))
+		return diags
+	}
+	diags.Append(resp.Plan.SetAttribute(ctx, path.Root(name), string(b))...)
+	return diags
+}
+
+func pageAssets(content string) []coveoPageAsset {
+	if content == "" {
+		return []coveoPageAsset{}
+	}
+	return []coveoPageAsset{{InlineContent: content}}
+}
+
+func pageAssetsContent(assets []coveoPageAsset) string {
+	if len(assets) == 0 {
+		return ""
+	}
+	return assets[0].InlineContent
+}
+
+func (m *coveoSearchPageModel) toAPI() coveoSearchPage {
+	return coveoSearchPage{
+		Name:        m.Name.ValueString(),
+		Title:       m.Title.ValueString(),
+		HTML:        m.HTML.ValueString(),
+		JavaScript:  pageAssets(m.JavaScript.ValueString()),
+		CSS:         pageAssets(m.CSS.ValueString()),
+		AccessLevel: m.Access.ValueString(),
+	}
+}
+
+func (m *coveoSearchPageModel) fromAPI(p *coveoSearchPage) {
+	m.Name = types.StringValue(p.Name)
+	m.Title = types.StringValue(p.Title)
+	m.HTML = types.StringValue(p.HTML)
+	m.JavaScript = types.StringValue(pageAssetsContent(p.JavaScript))
+	m.CSS = types.StringValue(pageAssetsContent(p.CSS))
+	m.Access = types.StringValue(p.AccessLevel)
+}
+
+func (r *CoveoSearchPageResource) read(ctx context.Context, m *coveoSearchPageModel) error {
+	body, err := r.client.DoPlatformRequest(ctx, "GET", "pages/"+url.PathEscape(m.ID.ValueString()), nil)
+	if err != nil {
+		return err
+	}
+	var page coveoSearchPage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return err
+	}
+	m.fromAPI(&page)
+	return nil
+}
+
+// Create creates the page.
+func (r *CoveoSearchPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoSearchPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.DoPlatformRequest(ctx, "POST", "pages", plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create search page: %s", err))
+		return
+	}
+	var created coveoSearchPage
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid page ID.")
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	if err := r.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read search page: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the page.
+func (r *CoveoSearchPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoSearchPageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read search page: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the page.
+func (r *CoveoSearchPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoSearchPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page := plan.toAPI()
+	page.ID = plan.ID.ValueString()
+	if _, err := r.client.DoPlatformRequest(ctx, "PUT", "pages/"+url.PathEscape(page.ID), page); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update search page: %s", err))
+		return
+	}
+	if err := r.read(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read search page: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the page.
+func (r *CoveoSearchPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoSearchPageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoPlatformRequest(ctx, "DELETE", "pages/"+url.PathEscape(state.ID.ValueString()), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete search page: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a page by ID.
+func (r *CoveoSearchPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
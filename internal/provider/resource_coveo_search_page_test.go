@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoSearchPageResource_ModifyPlanLoadsFiles(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for name, content := range map[string]string{"page.html": "<div id=\"search\"></div>", "page.css": "body { margin: 0; }"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	r := &CoveoSearchPageResource{}
+	s := testResourceSchema(ctx, r)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	config := map[string]tftypes.Value{
+		"name":       str("support"),
+		"html_file":  str(filepath.Join(dir, "page.html")),
+		"javascript": str("console.log('hi')"),
+		"css_file":   str(filepath.Join(dir, "page.css")),
+	}
+	plan := map[string]tftypes.Value{"id": unknown, "title": unknown, "html": unknown, "css": unknown, "access": str("PRIVATE")}
+	for name, v := range config {
+		plan[name] = v
+	}
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: testObjectValue(ctx, s, config)},
+		Plan:   tfsdk.Plan{Schema: s, Raw: testObjectValue(ctx, s, plan)},
+	}
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var got coveoSearchPageModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+	if got.HTML.ValueString() != "<div id=\"search\"></div>" {
+		t.Errorf("html = %s", got.HTML)
+	}
+	if got.CSS.ValueString() != "body { margin: 0; }" {
+		t.Errorf("css = %s", got.CSS)
+	}
+	if got.JavaScript.ValueString() != "console.log('hi')" {
+		t.Errorf("javascript = %s", got.JavaScript)
+	}
+
+	config["html_file"] = str(filepath.Join(dir, "missing.html"))
+	req.Config.Raw = testObjectValue(ctx, s, config)
+	resp = resource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(ctx, req, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a missing html_file")
+	}
+}
+
+func TestCoveoSearchPageResource_CreateReadsBack(t *testing.T) {
+	ctx := context.Background()
+	var sent coveoSearchPage
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/rest/organizations/myorg/pages":
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Fatal(err)
+			}
+			fmt.Fprint(w, `{"id":"p1"}`)
+		case r.Method == "GET" && r.URL.Path == "/rest/organizations/myorg/pages/p1":
+			fmt.Fprint(w, `{"id":"p1","name":"support","title":"support","html":"<div></div>","javascript":[],"css":[{"inlineContent":"body{}"}],"accessLevel":"PRIVATE"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	r := &CoveoSearchPageResource{client: client}
+	s := testResourceSchema(ctx, r)
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":         unknown,
+		"name":       str("support"),
+		"title":      unknown,
+		"html":       str("<div></div>"),
+		"javascript": str(""),
+		"css":        str("body{}"),
+		"access":     str("PRIVATE"),
+	})}}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	if len(sent.CSS) != 1 || sent.CSS[0].InlineContent != "body{}" || len(sent.JavaScript) != 0 {
+		t.Errorf("unexpected request %+v", sent)
+	}
+	var title string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("title"), &title)...)
+	if title != "support" {
+		t.Errorf("title = %q, want the title set by Coveo", title)
+	}
+}
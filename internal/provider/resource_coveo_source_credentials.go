@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &CoveoSourceCredentialsResource{}
+	_ resource.ResourceWithValidateConfig = &CoveoSourceCredentialsResource{}
+)
+
+// CoveoSourceCredentialsResource manages the credentials a source crawls
+// with. The secrets are write-only: they are sent to Coveo but never stored
+// in the plan or state, and credentials_version tells Terraform when to send
+// them again.
+type CoveoSourceCredentialsResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSourceCredentialsResource(client *CoveoClient) resource.Resource {
+	return &CoveoSourceCredentialsResource{client: client}
+}
+
+type coveoSourceCredentialsModel struct {
+	ID                 types.String `tfsdk:"id"`
+	SourceID           types.String `tfsdk:"source_id"`
+	Username           types.String `tfsdk:"username"`
+	PasswordWO         types.String `tfsdk:"password_wo"`
+	TokenWO            types.String `tfsdk:"token_wo"`
+	CredentialsVersion types.Int64  `tfsdk:"credentials_version"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoSourceCredentialsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_source_credentials"
+}
+
+// Schema defines the schema for the source credentials resource.
+func (r *CoveoSourceCredentialsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the credentials a Coveo source crawls with. " +
+			"password_wo and token_wo are write-only and never stored in the plan or state; they require Terraform 1.11 or later. " +
+			"Since Terraform cannot detect changes to write-only values, change credentials_version to rotate them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The source ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "The username the source authenticates with.",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The password the source authenticates with. Write-only.",
+			},
+			"token_wo": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The API token the source authenticates with. Write-only.",
+			},
+			"credentials_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Any value, changed to send password_wo and token_wo to Coveo again, such as after a rotation.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that a secret is set.
+func (r *CoveoSourceCredentialsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoSourceCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.PasswordWO.IsUnknown() || config.TokenWO.IsUnknown() {
+		return
+	}
+	if config.PasswordWO.IsNull() && config.TokenWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Invalid Configuration", "At least one of password_wo or token_wo must be set.")
+	}
+}
+
+// put sends the credentials of config, which holds the write-only secrets
+// that the plan does not. It returns the model to store in the state.
+func (r *CoveoSourceCredentialsResource) put(ctx context.Context, config tfsdk.Config, plan coveoSourceCredentialsModel) (coveoSourceCredentialsModel, error) {
+	var secrets coveoSourceCredentialsModel
+	if diags := config.Get(ctx, &secrets); diags.HasError() {
+		return plan, fmt.Errorf("reading write-only credentials from the configuration")
+	}
+
+	creds := coveo.SourceCredentials{
+		Username: plan.Username.ValueString(),
+		Password: secrets.PasswordWO.ValueString(),
+		Token:    secrets.TokenWO.ValueString(),
+	}
+	if err := r.client.Sources.PutCredentials(ctx, plan.SourceID.ValueString(), creds); err != nil {
+		return plan, err
+	}
+
+	plan.ID = plan.SourceID
+	plan.PasswordWO = types.StringNull()
+	plan.TokenWO = types.StringNull()
+	return plan, nil
+}
+
+// Create sets the source credentials.
+func (r *CoveoSourceCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoSourceCredentialsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.put(ctx, req.Config, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to set source credentials: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Read refreshes the username. The secrets are never read back.
+func (r *CoveoSourceCredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoSourceCredentialsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creds, err := r.client.Sources.GetCredentials(ctx, state.SourceID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read source credentials: %s", err))
+		return
+	}
+	if creds.Username != "" || !state.Username.IsNull() {
+		state.Username = types.StringValue(creds.Username)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update sends the credentials again, including the write-only secrets.
+func (r *CoveoSourceCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoSourceCredentialsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.put(ctx, req.Config, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update source credentials: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete removes the source credentials.
+func (r *CoveoSourceCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoSourceCredentialsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Sources.DeleteCredentials(ctx, state.SourceID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete source credentials: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports the credentials of a source by source ID. The secrets
+// are sent again on the next change of credentials_version.
+func (r *CoveoSourceCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_id"), req.ID)...)
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+// newTestCredentialsResource returns a credentials resource whose server
+// records the credentials it is sent.
+func newTestCredentialsResource(t *testing.T, sent *coveo.SourceCredentials) *CoveoSourceCredentialsResource {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/organizations/myorg/sources/src/credentials" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(sent); err != nil {
+			t.Fatal(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return &CoveoSourceCredentialsResource{client: client}
+}
+
+func TestCoveoSourceCredentialsResource_Create(t *testing.T) {
+	ctx := context.Background()
+	var sent coveo.SourceCredentials
+	r := newTestCredentialsResource(t, &sent)
+	s := testResourceSchema(ctx, r)
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	// Terraform sends write-only values in the configuration only.
+	config := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"source_id":           str("src"),
+		"username":            str("crawler"),
+		"password_wo":         str("s3cret"),
+		"credentials_version": tftypes.NewValue(tftypes.Number, 1),
+	})
+	plan := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"source_id":           str("src"),
+		"username":            str("crawler"),
+		"credentials_version": tftypes.NewValue(tftypes.Number, 1),
+	})
+
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+	}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	r.Create(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	if sent.Username != "crawler" || sent.Password != "s3cret" {
+		t.Errorf("unexpected credentials sent %+v", sent)
+	}
+	var state coveoSourceCredentialsModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID != types.StringValue("src") {
+		t.Errorf("id = %s, want src", state.ID)
+	}
+	if !state.PasswordWO.IsNull() || !state.TokenWO.IsNull() {
+		t.Errorf("write-only values must not be stored, got %s and %s", state.PasswordWO, state.TokenWO)
+	}
+}
+
+func TestCoveoSourceCredentialsResource_UpdateVersion(t *testing.T) {
+	ctx := context.Background()
+	var sent coveo.SourceCredentials
+	r := newTestCredentialsResource(t, &sent)
+	s := testResourceSchema(ctx, r)
+	str := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	// Only credentials_version changed; the rotated secrets are in the
+	// configuration, never in the prior state or the plan.
+	config := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"source_id":           str("src"),
+		"password_wo":         str("rotated"),
+		"token_wo":            str("t0ken"),
+		"credentials_version": tftypes.NewValue(tftypes.Number, 2),
+	})
+	plan := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":                  str("src"),
+		"source_id":           str("src"),
+		"credentials_version": tftypes.NewValue(tftypes.Number, 2),
+	})
+	prior := testObjectValue(ctx, s, map[string]tftypes.Value{
+		"id":                  str("src"),
+		"source_id":           str("src"),
+		"credentials_version": tftypes.NewValue(tftypes.Number, 1),
+	})
+
+	req := resource.UpdateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: config},
+		Plan:   tfsdk.Plan{Schema: s, Raw: plan},
+		State:  tfsdk.State{Schema: s, Raw: prior},
+	}
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: prior}}
+	r.Update(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	if sent.Password != "rotated" || sent.Token != "t0ken" {
+		t.Errorf("secrets were not sent again: %+v", sent)
+	}
+	var state coveoSourceCredentialsModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.CredentialsVersion != types.Int64Value(2) {
+		t.Errorf("credentials_version = %s, want 2", state.CredentialsVersion)
+	}
+	if !state.PasswordWO.IsNull() || !state.TokenWO.IsNull() {
+		t.Errorf("write-only values must not be stored, got %s and %s", state.PasswordWO, state.TokenWO)
+	}
+}
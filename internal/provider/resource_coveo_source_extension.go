@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &CoveoSourceExtensionResource{}
+	_ resource.ResourceWithValidateConfig = &CoveoSourceExtensionResource{}
+)
+
+// Extension stages of a source indexing pipeline.
+const (
+	stagePreConversion  = "PRE_CONVERSION"
+	stagePostConversion = "POST_CONVERSION"
+)
+
+// extensionStages lists the stages an extension can be applied to.
+var extensionStages = []string{stagePreConversion, stagePostConversion}
+
+// sourceExtensionsMu serializes changes to source extension lists, which are
+// read, modified and written back as a whole.
+var sourceExtensionsMu sync.Mutex
+
+// sourceExtensionOrders records the order configured for each attachment
+// applied by this process, keyed by ID, so that attachments applied in
+// parallel end up in their configured order whichever is applied first.
+// It is guarded by sourceExtensionsMu.
+var sourceExtensionOrders = map[string]int64{}
+
+// sourceExtensionID returns the ID of the attachment of extensionID to a
+// stage of sourceID.
+func sourceExtensionID(sourceID, stage, extensionID string) string {
+	return strings.Join([]string{sourceID, stage, extensionID}, "/")
+}
+
+// sourceExtensionPosition returns where an extension configured at order
+// goes in list: before the first extension whose order is at least order. The
+// order of an extension is the configured one if this process applied it, and
+// its current position otherwise.
+func sourceExtensionPosition(list []coveoSourceExtension, sourceID, stage string, order int64) int {
+	for i, e := range list {
+		o, ok := sourceExtensionOrders[sourceExtensionID(sourceID, stage, e.ExtensionID)]
+		if !ok {
+			o = int64(i)
+		}
+		if o >= order {
+			return i
+		}
+	}
+	return len(list)
+}
+
+// coveoSourceExtension is an extension applied by a source.
+type coveoSourceExtension struct {
+	ExtensionID   string `json:"extensionId"`
+	Condition     string `json:"condition,omitempty"`
+	ActionOnError string `json:"actionOnError,omitempty"`
+}
+
+// coveoSourceExtensions are the extensions of both stages of a source.
+type coveoSourceExtensions struct {
+	PreConversionExtensions  []coveoSourceExtension `json:"preConversionExtensions"`
+	PostConversionExtensions []coveoSourceExtension `json:"postConversionExtensions"`
+}
+
+func (e *coveoSourceExtensions) stage(stage string) *[]coveoSourceExtension {
+	if stage == stagePreConversion {
+		return &e.PreConversionExtensions
+	}
+	return &e.PostConversionExtensions
+}
+
+// CoveoSourceExtensionResource attaches an extension to a stage of a source.
+type CoveoSourceExtensionResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSourceExtensionResource(client *CoveoClient) resource.Resource {
+	return &CoveoSourceExtensionResource{client: client}
+}
+
+type coveoSourceExtensionModel struct {
+	ID            types.String `tfsdk:"id"`
+	SourceID      types.String `tfsdk:"source_id"`
+	ExtensionID   types.String `tfsdk:"extension_id"`
+	Stage         types.String `tfsdk:"stage"`
+	Condition     types.String `tfsdk:"condition"`
+	ActionOnError types.String `tfsdk:"action_on_error"`
+	Order         types.Int64  `tfsdk:"order"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoSourceExtensionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_source_extension"
+}
+
+// Schema defines the schema for the source extension resource.
+func (r *CoveoSourceExtensionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Applies an indexing pipeline extension to a Coveo source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The attachment ID, in the form source_id/stage/extension_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the source applying the extension.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"extension_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the extension to apply.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"stage": schema.StringAttribute{
+				Required:    true,
+				Description: "The stage running the extension: PRE_CONVERSION or POST_CONVERSION.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"condition": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "A condition on document metadata restricting the documents the extension runs on.",
+			},
+			"action_on_error": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("SKIP_EXTENSION"),
+				Description: "What to do when the extension fails: SKIP_EXTENSION or REJECT_DOCUMENT.",
+			},
+			"order": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The zero-based position of the extension in the stage. Extensions run in ascending order. Defaults to the end of the stage.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the stage and order.
+func (r *CoveoSourceExtensionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoSourceExtensionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.Stage; !v.IsNull() && !v.IsUnknown() && !oneOf(v.ValueString(), extensionStages) {
+		resp.Diagnostics.AddAttributeError(path.Root("stage"), "Invalid Configuration",
+			fmt.Sprintf("stage must be one of %s, got %q.", strings.Join(extensionStages, ", "), v.ValueString()))
+	}
+	if v := config.Order; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("order"), "Invalid Configuration", "order must be at least 0.")
+	}
+}
+
+func (r *CoveoSourceExtensionResource) readExtensions(ctx context.Context, sourceID string) (*coveoSourceExtensions, error) {
+	body, err := r.client.DoPlatformRequest(ctx, "GET", fmt.Sprintf("sources/%s/extensions", url.PathEscape(sourceID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	var exts coveoSourceExtensions
+	if err := json.Unmarshal(body, &exts); err != nil {
+		return nil, err
+	}
+	return &exts, nil
+}
+
+func (r *CoveoSourceExtensionResource) writeExtensions(ctx context.Context, sourceID string, exts *coveoSourceExtensions) error {
+	_, err := r.client.DoPlatformRequest(ctx, "PUT", fmt.Sprintf("sources/%s/extensions", url.PathEscape(sourceID)), exts)
+	return err
+}
+
+// upsert places the extension described by m at its configured order,
+// replacing any previous occurrence in the stage. An order past the end of
+// the stage places it last, and Read then reports its actual position.
+func (r *CoveoSourceExtensionResource) upsert(ctx context.Context, m *coveoSourceExtensionModel) error {
+	stage := m.Stage.ValueString()
+	id := sourceExtensionID(m.SourceID.ValueString(), stage, m.ExtensionID.ValueString())
+
+	sourceExtensionsMu.Lock()
+	defer sourceExtensionsMu.Unlock()
+
+	exts, err := r.readExtensions(ctx, m.SourceID.ValueString())
+	if err != nil {
+		return err
+	}
+	list := removeSourceExtension(*exts.stage(stage), m.ExtensionID.ValueString())
+
+	pos := len(list)
+	if !m.Order.IsNull() && !m.Order.IsUnknown() {
+		pos = sourceExtensionPosition(list, m.SourceID.ValueString(), stage, m.Order.ValueInt64())
+	}
+	entry := coveoSourceExtension{
+		ExtensionID:   m.ExtensionID.ValueString(),
+		Condition:     m.Condition.ValueString(),
+		ActionOnError: m.ActionOnError.ValueString(),
+	}
+	list = append(list[:pos], append([]coveoSourceExtension{entry}, list[pos:]...)...)
+	*exts.stage(stage) = list
+
+	if err := r.writeExtensions(ctx, m.SourceID.ValueString(), exts); err != nil {
+		return err
+	}
+	m.ID = types.StringValue(id)
+	if m.Order.IsNull() || m.Order.IsUnknown() {
+		m.Order = types.Int64Value(int64(pos))
+	}
+	sourceExtensionOrders[id] = m.Order.ValueInt64()
+	return nil
+}
+
+// removeSourceExtension returns list without the entries for extensionID.
+func removeSourceExtension(list []coveoSourceExtension, extensionID string) []coveoSourceExtension {
+	out := make([]coveoSourceExtension, 0, len(list))
+	for _, e := range list {
+		if e.ExtensionID != extensionID {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// Create applies the extension to the source.
+func (r *CoveoSourceExtensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoSourceExtensionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsert(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to apply extension to source: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the attachment from the source.
+func (r *CoveoSourceExtensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoSourceExtensionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exts, err := r.readExtensions(ctx, state.SourceID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read source extensions: %s", err))
+		return
+	}
+
+	for i, e := range *exts.stage(state.Stage.ValueString()) {
+		if e.ExtensionID == state.ExtensionID.ValueString() {
+			state.Condition = types.StringValue(e.Condition)
+			state.ActionOnError = types.StringValue(e.ActionOnError)
+			state.Order = types.Int64Value(int64(i))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// Update moves or reconfigures the extension within its stage.
+func (r *CoveoSourceExtensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoSourceExtensionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsert(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update source extension: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the extension from the source stage.
+func (r *CoveoSourceExtensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoSourceExtensionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourceExtensionsMu.Lock()
+	defer sourceExtensionsMu.Unlock()
+
+	exts, err := r.readExtensions(ctx, state.SourceID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read source extensions: %s", err))
+		return
+	}
+	stage := exts.stage(state.Stage.ValueString())
+	*stage = removeSourceExtension(*stage, state.ExtensionID.ValueString())
+	if err := r.writeExtensions(ctx, state.SourceID.ValueString(), exts); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to remove extension from source: %s", err))
+		return
+	}
+	delete(sourceExtensionOrders, state.ID.ValueString())
+}
+
+// ImportState imports an attachment from an ID in the form
+// source_id/stage/extension_id.
+func (r *CoveoSourceExtensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "source_id", "stage", "extension_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("stage"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("extension_id"), parts[2])...)
+}
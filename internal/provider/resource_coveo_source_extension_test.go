@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCoveoSourceExtensionResource_Upsert(t *testing.T) {
+	t.Cleanup(func() { sourceExtensionOrders = map[string]int64{} })
+
+	var saved coveoSourceExtensions
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/sources/src1/extensions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"preConversionExtensions":[{"extensionId":"a"},{"extensionId":"b"},{"extensionId":"c"}],"postConversionExtensions":[{"extensionId":"z"}]}`)
+		case "PUT":
+			if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+	r := &CoveoSourceExtensionResource{client: client}
+
+	m := coveoSourceExtensionModel{
+		SourceID:      types.StringValue("src1"),
+		ExtensionID:   types.StringValue("c"),
+		Stage:         types.StringValue(stagePreConversion),
+		Condition:     types.StringValue("@filetype==pdf"),
+		ActionOnError: types.StringValue("SKIP_EXTENSION"),
+		Order:         types.Int64Value(0),
+	}
+	if err := r.upsert(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, e := range saved.PreConversionExtensions {
+		got = append(got, e.ExtensionID)
+	}
+	if fmt.Sprint(got) != "[c a b]" {
+		t.Errorf("pre-conversion order = %v, want [c a b]", got)
+	}
+	if saved.PreConversionExtensions[0].Condition != "@filetype==pdf" {
+		t.Errorf("condition not saved: %+v", saved.PreConversionExtensions[0])
+	}
+	if len(saved.PostConversionExtensions) != 1 {
+		t.Errorf("post-conversion stage was modified: %+v", saved.PostConversionExtensions)
+	}
+	if m.ID.ValueString() != "src1/PRE_CONVERSION/c" || m.Order.ValueInt64() != 0 {
+		t.Errorf("unexpected model %+v", m)
+	}
+}
+
+func TestCoveoSourceExtensionResource_UpsertOrderPastEnd(t *testing.T) {
+	t.Cleanup(func() { sourceExtensionOrders = map[string]int64{} })
+
+	var saved coveoSourceExtensions
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"preConversionExtensions":[{"extensionId":"a"},{"extensionId":"b"}],"postConversionExtensions":[]}`)
+		case "PUT":
+			if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+	r := &CoveoSourceExtensionResource{client: client}
+
+	m := coveoSourceExtensionModel{
+		SourceID:      types.StringValue("src1"),
+		ExtensionID:   types.StringValue("c"),
+		Stage:         types.StringValue(stagePreConversion),
+		Condition:     types.StringValue(""),
+		ActionOnError: types.StringValue("SKIP_EXTENSION"),
+		Order:         types.Int64Value(5),
+	}
+	if err := r.upsert(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(saved.PreConversionExtensions); n != 3 || saved.PreConversionExtensions[2].ExtensionID != "c" {
+		t.Errorf("unexpected pre-conversion extensions %+v", saved.PreConversionExtensions)
+	}
+	if m.Order.ValueInt64() != 5 {
+		t.Errorf("order was changed to %d", m.Order.ValueInt64())
+	}
+}
+
+func TestCoveoSourceExtensionResource_UpsertInAnyApplyOrder(t *testing.T) {
+	t.Cleanup(func() { sourceExtensionOrders = map[string]int64{} })
+
+	exts := coveoSourceExtensions{PreConversionExtensions: []coveoSourceExtension{}, PostConversionExtensions: []coveoSourceExtension{}}
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			if err := json.NewEncoder(w).Encode(exts); err != nil {
+				t.Fatal(err)
+			}
+		case "PUT":
+			if err := json.NewDecoder(r.Body).Decode(&exts); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+	r := &CoveoSourceExtensionResource{client: client}
+
+	// Terraform may apply attachments of the same stage in any order.
+	for _, c := range []struct {
+		id    string
+		order int64
+	}{{"c", 2}, {"a", 0}, {"b", 1}} {
+		m := coveoSourceExtensionModel{
+			SourceID:      types.StringValue("src1"),
+			ExtensionID:   types.StringValue(c.id),
+			Stage:         types.StringValue(stagePreConversion),
+			Condition:     types.StringValue(""),
+			ActionOnError: types.StringValue("SKIP_EXTENSION"),
+			Order:         types.Int64Value(c.order),
+		}
+		if err := r.upsert(context.Background(), &m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	for _, e := range exts.PreConversionExtensions {
+		got = append(got, e.ExtensionID)
+	}
+	if fmt.Sprint(got) != "[a b c]" {
+		t.Errorf("pre-conversion order = %v, want [a b c]", got)
+	}
+}
+
+func TestCoveoSourceExtensionResource_UpsertAppendsWithoutOrder(t *testing.T) {
+	t.Cleanup(func() { sourceExtensionOrders = map[string]int64{} })
+
+	var saved coveoSourceExtensions
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"preConversionExtensions":[],"postConversionExtensions":[{"extensionId":"a"},{"extensionId":"b"}]}`)
+		case "PUT":
+			if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+	r := &CoveoSourceExtensionResource{client: client}
+
+	m := coveoSourceExtensionModel{
+		SourceID:      types.StringValue("src1"),
+		ExtensionID:   types.StringValue("c"),
+		Stage:         types.StringValue(stagePostConversion),
+		Condition:     types.StringValue(""),
+		ActionOnError: types.StringValue("SKIP_EXTENSION"),
+		Order:         types.Int64Unknown(),
+	}
+	if err := r.upsert(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(saved.PostConversionExtensions); n != 3 || saved.PostConversionExtensions[2].ExtensionID != "c" {
+		t.Errorf("unexpected post-conversion extensions %+v", saved.PostConversionExtensions)
+	}
+	if m.Order.ValueInt64() != 2 {
+		t.Errorf("order = %d, want 2", m.Order.ValueInt64())
+	}
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+var _ resource.ResourceWithValidateConfig = &CoveoSourceRefreshResource{}
+
+// sourceOperations maps the operations accepted by the provider to the source
+// operation endpoints of the Platform API.
+var sourceOperations = map[string]string{
+	"REBUILD": coveo.SourceOperationRebuild,
+	"RESCAN":  coveo.SourceOperationRescan,
+	"REFRESH": coveo.SourceOperationRefresh,
+}
+
+// sourcePollInterval is the delay between two checks while waiting for a
+// source operation to complete.
+var sourcePollInterval = 10 * time.Second
+
+// defaultSourceOperationTimeout is used when operation_timeout is not set.
+const defaultSourceOperationTimeout = "2h"
+
+// waitForSourceOperation polls the source status until an operation other
+// than previousID completes, and fails if that operation reports an error.
+func waitForSourceOperation(ctx context.Context, client *CoveoClient, sourceID, previousID string, timeout time.Duration) (*coveo.SourceOperation, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(sourcePollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := client.Sources.Status(ctx, sourceID)
+		if err != nil && ctx.Err() == nil {
+			return nil, fmt.Errorf("reading source status: %w", err)
+		}
+		if err == nil {
+			// Until a new operation shows up, the status describes an earlier
+			// one, which may have failed without this operation failing.
+			if op := status.LastOperation; op != nil && op.ID != previousID {
+				if status.Type == coveo.SourceStatusError || op.Result == coveo.SourceOperationFailed {
+					return nil, sourceOperationError(sourceID, op)
+				}
+				if status.Type == coveo.SourceStatusIdle {
+					return op, nil
+				}
+			}
+			tflog.Debug(ctx, "Source operation not completed yet", map[string]interface{}{"source_id": sourceID, "status": status.Type})
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("operation on source %s did not complete within %s", sourceID, timeout)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// sourceOperationError describes the error reported for an operation.
+func sourceOperationError(sourceID string, op *coveo.SourceOperation) error {
+	if op == nil || op.ErrorCode == "" {
+		return fmt.Errorf("operation on source %s failed", sourceID)
+	}
+	msg := op.ErrorCode
+	if op.ErrorDetails != "" {
+		msg = fmt.Sprintf("%s: %s", msg, op.ErrorDetails)
+	}
+	return fmt.Errorf("%s of source %s failed: %s", op.OperationType, sourceID, msg)
+}
+
+// CoveoSourceRefreshResource starts a rebuild, rescan or refresh of a source
+// when it is created and whenever its triggers change.
+type CoveoSourceRefreshResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSourceRefreshResource(client *CoveoClient) resource.Resource {
+	return &CoveoSourceRefreshResource{client: client}
+}
+
+type coveoSourceRefreshModel struct {
+	ID                types.String `tfsdk:"id"`
+	SourceID          types.String `tfsdk:"source_id"`
+	Operation         types.String `tfsdk:"operation"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	OperationTimeout  types.String `tfsdk:"operation_timeout"`
+	TriggeredDate     types.String `tfsdk:"triggered_date"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoSourceRefreshResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_source_refresh"
+}
+
+func sourceOperationNames() []string {
+	names := make([]string, 0, len(sourceOperations))
+	for name := range sourceOperations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Schema defines the schema for the source refresh resource.
+func (r *CoveoSourceRefreshResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rebuilds, rescans or refreshes a Coveo source when created and whenever triggers change. " +
+			"Destroying the resource does not affect the source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The source ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the source to refresh.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("REBUILD"),
+				Description: fmt.Sprintf("The operation to run: %s. Defaults to REBUILD.", strings.Join(sourceOperationNames(), ", ")),
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that run the operation again when they change, such as the hash of a mapping or extension.",
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Wait until the operation completes. Errors reported in the source status fail the apply.",
+			},
+			"operation_timeout": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultSourceOperationTimeout),
+				Description: "How long to wait for the operation when wait_for_completion is set, as a Go duration such as 2h.",
+			},
+			"triggered_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "When the operation was last started, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the operation.
+func (r *CoveoSourceRefreshResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoSourceRefreshModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.Operation; !v.IsNull() && !v.IsUnknown() && !oneOf(v.ValueString(), sourceOperationNames()) {
+		resp.Diagnostics.AddAttributeError(path.Root("operation"), "Invalid Configuration",
+			fmt.Sprintf("operation must be one of %s, got %q.", strings.Join(sourceOperationNames(), ", "), v.ValueString()))
+	}
+}
+
+// run starts the planned operation and, when requested, waits for it.
+func (r *CoveoSourceRefreshResource) run(ctx context.Context, plan *coveoSourceRefreshModel) error {
+	timeout, err := time.ParseDuration(plan.OperationTimeout.ValueString())
+	if err != nil {
+		return fmt.Errorf("operation_timeout must be a duration such as 2h: %w", err)
+	}
+
+	sourceID := plan.SourceID.ValueString()
+	var previousID string
+	if plan.WaitForCompletion.ValueBool() {
+		status, err := r.client.Sources.Status(ctx, sourceID)
+		if err != nil {
+			return fmt.Errorf("reading source status: %w", err)
+		}
+		if status.LastOperation != nil {
+			previousID = status.LastOperation.ID
+		}
+	}
+
+	if err := r.client.Sources.StartOperation(ctx, sourceID, sourceOperations[plan.Operation.ValueString()]); err != nil {
+		return err
+	}
+	plan.TriggeredDate = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	if plan.WaitForCompletion.ValueBool() {
+		if _, err := waitForSourceOperation(ctx, r.client, sourceID, previousID, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Create runs the operation.
+func (r *CoveoSourceRefreshResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoSourceRefreshModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.SourceID
+	if err := r.run(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Source Operation Error", fmt.Sprintf("Failed to refresh source: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the resource from the state when its source no longer exists.
+func (r *CoveoSourceRefreshResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoSourceRefreshModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.Sources.Get(ctx, state.SourceID.ValueString()); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read source: %s", err))
+	}
+}
+
+// Update runs the operation again when the triggers changed.
+func (r *CoveoSourceRefreshResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state coveoSourceRefreshModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.TriggeredDate = state.TriggeredDate
+	if !plan.Triggers.Equal(state.Triggers) {
+		if err := r.run(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Source Operation Error", fmt.Sprintf("Failed to refresh source: %s", err))
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the resource from the state.
+func (r *CoveoSourceRefreshResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitForSourceOperation(t *testing.T) {
+	sourcePollInterval = time.Millisecond
+	t.Cleanup(func() { sourcePollInterval = 10 * time.Second })
+
+	var reads int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/organizations/myorg/sources/src/status" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		reads++
+		switch {
+		case reads == 1:
+			// The operation has not started yet.
+			fmt.Fprint(w, `{"type":"IDLE","lastOperation":{"id":"op1","result":"SUCCESS"}}`)
+		case reads == 2:
+			fmt.Fprint(w, `{"type":"REBUILDING","lastOperation":{"id":"op1","result":"SUCCESS"}}`)
+		default:
+			fmt.Fprint(w, `{"type":"IDLE","lastOperation":{"id":"op2","operationType":"REBUILD","result":"SUCCESS"}}`)
+		}
+	})
+
+	op, err := waitForSourceOperation(context.Background(), client, "src", "op1", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.ID != "op2" || reads != 3 {
+		t.Errorf("got operation %+v after %d reads, want op2 after 3", op, reads)
+	}
+}
+
+func TestWaitForSourceOperation_Error(t *testing.T) {
+	sourcePollInterval = time.Millisecond
+	t.Cleanup(func() { sourcePollInterval = 10 * time.Second })
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"type":"IDLE","lastOperation":{"id":"op2","operationType":"REBUILD","result":"ERROR","errorCode":"INVALID_CREDENTIALS","errorDetails":"login refused"}}`)
+	})
+
+	_, err := waitForSourceOperation(context.Background(), client, "src", "op1", time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "INVALID_CREDENTIALS: login refused") {
+		t.Errorf("expected operation error, got %v", err)
+	}
+}
+
+func TestWaitForSourceOperation_PreviousError(t *testing.T) {
+	sourcePollInterval = time.Millisecond
+	t.Cleanup(func() { sourcePollInterval = 10 * time.Second })
+
+	var reads int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		if reads < 3 {
+			// The source is still in error from the previous operation.
+			fmt.Fprint(w, `{"type":"ERROR","lastOperation":{"id":"op1","operationType":"REBUILD","result":"ERROR","errorCode":"INVALID_CREDENTIALS"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"type":"IDLE","lastOperation":{"id":"op2","operationType":"REBUILD","result":"SUCCESS"}}`)
+	})
+
+	op, err := waitForSourceOperation(context.Background(), client, "src", "op1", time.Minute)
+	if err != nil {
+		t.Fatalf("the error of the previous operation failed the wait: %v", err)
+	}
+	if op.ID != "op2" || reads != 3 {
+		t.Errorf("got operation %+v after %d reads, want op2 after 3", op, reads)
+	}
+}
@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	// Embed the time zone database so that time zones are validated the same
+	// way on hosts without one, such as Windows.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &CoveoSourceScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &CoveoSourceScheduleResource{}
+)
+
+// scheduleRefreshTypes maps the operations accepted by the provider, shared
+// with coveo_source_refresh, to schedule refresh types of the Platform API.
+var scheduleRefreshTypes = map[string]string{
+	"REBUILD": coveo.ScheduleRefreshRebuild,
+	"RESCAN":  coveo.ScheduleRefreshRescan,
+	"REFRESH": coveo.ScheduleRefreshIncremental,
+}
+
+// scheduleOperation returns the provider operation of a schedule refresh type.
+func scheduleOperation(refreshType string) string {
+	for op, t := range scheduleRefreshTypes {
+		if t == refreshType {
+			return op
+		}
+	}
+	return refreshType
+}
+
+// validateCron checks that expr has the five fields of a cron expression.
+// The values themselves are validated by the API.
+func validateCron(expr string) error {
+	if fields := strings.Fields(expr); len(fields) != 5 {
+		return fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+	return nil
+}
+
+// CoveoSourceScheduleResource manages a refresh, rescan or rebuild schedule
+// of a source.
+type CoveoSourceScheduleResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoSourceScheduleResource(client *CoveoClient) resource.Resource {
+	return &CoveoSourceScheduleResource{client: client}
+}
+
+type coveoSourceScheduleModel struct {
+	ID        types.String `tfsdk:"id"`
+	SourceID  types.String `tfsdk:"source_id"`
+	Operation types.String `tfsdk:"operation"`
+	Cron      types.String `tfsdk:"cron"`
+	TimeZone  types.String `tfsdk:"time_zone"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoSourceScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_source_schedule"
+}
+
+// Schema defines the schema for the source schedule resource.
+func (r *CoveoSourceScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a schedule running a refresh, rescan or rebuild of a Coveo source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The schedule ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the scheduled source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operation": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("REFRESH"),
+				Description: fmt.Sprintf("The operation to run: %s. Defaults to REFRESH.", strings.Join(sourceOperationNames(), ", ")),
+			},
+			"cron": schema.StringAttribute{
+				Required:    true,
+				Description: "When the operation runs, as a five-field cron expression such as \"0 2 * * SUN\".",
+			},
+			"time_zone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("UTC"),
+				Description: "The IANA time zone of the cron expression, such as America/Montreal. Defaults to UTC.",
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the schedule runs. Defaults to true.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the operation, cron expression and time zone.
+func (r *CoveoSourceScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoSourceScheduleModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.Operation; !v.IsNull() && !v.IsUnknown() && !oneOf(v.ValueString(), sourceOperationNames()) {
+		resp.Diagnostics.AddAttributeError(path.Root("operation"), "Invalid Configuration",
+			fmt.Sprintf("operation must be one of %s, got %q.", strings.Join(sourceOperationNames(), ", "), v.ValueString()))
+	}
+	if v := config.Cron; !v.IsNull() && !v.IsUnknown() {
+		if err := validateCron(v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("cron"), "Invalid Configuration", fmt.Sprintf("Invalid cron expression: %s.", err))
+		}
+	}
+	if v := config.TimeZone; !v.IsNull() && !v.IsUnknown() {
+		if _, err := time.LoadLocation(v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("time_zone"), "Invalid Configuration", fmt.Sprintf("Unknown time zone %q.", v.ValueString()))
+		}
+	}
+}
+
+func (m *coveoSourceScheduleModel) toAPI() coveo.Schedule {
+	return coveo.Schedule{
+		ID:          m.ID.ValueString(),
+		Enabled:     m.Enabled.ValueBool(),
+		RefreshType: scheduleRefreshTypes[m.Operation.ValueString()],
+		Recurrence: coveo.ScheduleRecurrence{
+			CronExpression: m.Cron.ValueString(),
+			TimeZone:       m.TimeZone.ValueString(),
+		},
+	}
+}
+
+func (m *coveoSourceScheduleModel) fromAPI(schedule *coveo.Schedule) {
+	m.ID = types.StringValue(schedule.ID)
+	m.Enabled = types.BoolValue(schedule.Enabled)
+	m.Operation = types.StringValue(scheduleOperation(schedule.RefreshType))
+	m.Cron = types.StringValue(schedule.Recurrence.CronExpression)
+	m.TimeZone = types.StringValue(schedule.Recurrence.TimeZone)
+}
+
+// Create adds the schedule to the source.
+func (r *CoveoSourceScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoSourceScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.Sources.CreateSchedule(ctx, plan.SourceID.ValueString(), plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create source schedule: %s", err))
+		return
+	}
+	plan.ID = types.StringValue(created.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the schedule.
+func (r *CoveoSourceScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoSourceScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.Sources.GetSchedule(ctx, state.SourceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read source schedule: %s", err))
+		return
+	}
+	state.fromAPI(schedule)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the schedule.
+func (r *CoveoSourceScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoSourceScheduleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.Sources.UpdateSchedule(ctx, plan.SourceID.ValueString(), plan.toAPI()); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update source schedule: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the schedule from the source.
+func (r *CoveoSourceScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoSourceScheduleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.Sources.DeleteSchedule(ctx, state.SourceID.ValueString(), state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete source schedule: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a schedule from an ID in the form source_id/schedule_id.
+func (r *CoveoSourceScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts, err := splitImportID(req.ID, "source_id", "schedule_id")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_id"), parts[0])...)
+}
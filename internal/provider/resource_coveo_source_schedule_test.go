@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-coveo/internal/coveo"
+)
+
+func TestValidateCron(t *testing.T) {
+	for expr, valid := range map[string]bool{
+		"0 2 * * SUN":   true,
+		"*/15 * * * *":  true,
+		"0 2 * *":       false,
+		"0 0 2 * * SUN": false,
+		"":              false,
+	} {
+		if err := validateCron(expr); (err == nil) != valid {
+			t.Errorf("validateCron(%q) = %v, want valid %v", expr, err, valid)
+		}
+	}
+}
+
+func TestCoveoSourceScheduleModel_RoundTrip(t *testing.T) {
+	for op := range scheduleRefreshTypes {
+		m := coveoSourceScheduleModel{
+			ID:        types.StringValue("sch"),
+			Operation: types.StringValue(op),
+			Cron:      types.StringValue("0 2 * * SUN"),
+			TimeZone:  types.StringValue("America/Montreal"),
+			Enabled:   types.BoolValue(true),
+		}
+		schedule := m.toAPI()
+		if schedule.RefreshType == "" {
+			t.Errorf("operation %s has no refresh type", op)
+		}
+
+		var got coveoSourceScheduleModel
+		got.fromAPI(&schedule)
+		if got.Operation.ValueString() != op || got.Cron != m.Cron || got.TimeZone != m.TimeZone {
+			t.Errorf("round trip of %s gave %+v", op, got)
+		}
+	}
+
+	var got coveoSourceScheduleModel
+	got.fromAPI(&coveo.Schedule{RefreshType: "FULL_REFRESH"})
+	if got.Operation.ValueString() != "FULL_REFRESH" {
+		t.Errorf("unknown refresh types should be kept, got %s", got.Operation)
+	}
+}
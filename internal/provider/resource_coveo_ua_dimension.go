@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithImportState    = &CoveoUADimensionResource{}
+	_ resource.ResourceWithValidateConfig = &CoveoUADimensionResource{}
+)
+
+// uaCustomDimensionPrefix prefixes the API name of every custom dimension.
+const uaCustomDimensionPrefix = "c_"
+
+// coveoUADimension is a custom dimension of the Usage Analytics API.
+type coveoUADimension struct {
+	APIName     string   `json:"apiName,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Type        string   `json:"type"`
+	EventTypes  []string `json:"eventTypes,omitempty"`
+}
+
+// CoveoUADimensionResource manages a usage analytics custom dimension.
+type CoveoUADimensionResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoUADimensionResource(client *CoveoClient) resource.Resource {
+	return &CoveoUADimensionResource{client: client}
+}
+
+type coveoUADimensionModel struct {
+	APIName     types.String `tfsdk:"api_name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	DataType    types.String `tfsdk:"data_type"`
+	EventTypes  types.Set    `tfsdk:"event_types"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoUADimensionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_ua_dimension"
+}
+
+// Schema defines the schema for the usage analytics dimension resource.
+func (r *CoveoUADimensionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo usage analytics custom dimension.",
+		Attributes: map[string]schema.Attribute{
+			"api_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The API name of the dimension. It must start with c_, such as c_team.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the dimension in reports.",
+			},
+			"data_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the dimension values: TEXT or NUMBER.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"event_types": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The events carrying the dimension: SEARCH, CLICK, CUSTOM_EVENT or VIEW.",
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the custom dimension prefix of api_name.
+func (r *CoveoUADimensionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config coveoUADimensionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if v := config.APIName; !v.IsNull() && !v.IsUnknown() && !strings.HasPrefix(v.ValueString(), uaCustomDimensionPrefix) {
+		resp.Diagnostics.AddAttributeError(path.Root("api_name"), "Invalid Configuration",
+			fmt.Sprintf("api_name must start with %s, got %q.", uaCustomDimensionPrefix, v.ValueString()))
+	}
+}
+
+// save creates or updates the dimension. The event types are passed as query
+// parameters.
+func (r *CoveoUADimensionResource) save(ctx context.Context, method string, m *coveoUADimensionModel) error {
+	apiName := m.APIName.ValueString()
+	var events []string
+	if diags := m.EventTypes.ElementsAs(ctx, &events, false); diags.HasError() {
+		return fmt.Errorf("invalid event_types")
+	}
+
+	query := url.Values{}
+	for _, e := range events {
+		query.Add("event", e)
+	}
+	endpoint := "dimensions/custom"
+	if method == "POST" {
+		query.Set("name", apiName)
+	} else {
+		endpoint = "dimensions/custom/" + url.PathEscape(apiName)
+	}
+	_, err := r.client.DoUARequest(ctx, method, endpoint, query, coveoUADimension{
+		DisplayName: m.DisplayName.ValueString(),
+		Type:        m.DataType.ValueString(),
+	})
+	return err
+}
+
+func (r *CoveoUADimensionResource) read(ctx context.Context, m *coveoUADimensionModel) error {
+	body, err := r.client.DoUARequest(ctx, "GET", "dimensions/custom/"+url.PathEscape(m.APIName.ValueString()), nil, nil)
+	if err != nil {
+		return err
+	}
+	var dim coveoUADimension
+	if err := json.Unmarshal(body, &dim); err != nil {
+		return err
+	}
+	m.DisplayName = types.StringValue(dim.DisplayName)
+	m.DataType = types.StringValue(dim.Type)
+	events, diags := types.SetValueFrom(ctx, types.StringType, dim.EventTypes)
+	if diags.HasError() {
+		return fmt.Errorf("invalid event types in response")
+	}
+	m.EventTypes = events
+	return nil
+}
+
+// Create creates the dimension.
+func (r *CoveoUADimensionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoUADimensionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.save(ctx, "POST", &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create usage analytics dimension: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the dimension.
+func (r *CoveoUADimensionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoUADimensionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read usage analytics dimension: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update changes the display name or event types of the dimension.
+func (r *CoveoUADimensionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoUADimensionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.save(ctx, "PUT", &plan); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update usage analytics dimension: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the dimension.
+func (r *CoveoUADimensionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoUADimensionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoUARequest(ctx, "DELETE", "dimensions/custom/"+url.PathEscape(state.APIName.ValueString()), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete usage analytics dimension: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a dimension by API name.
+func (r *CoveoUADimensionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("api_name"), req, resp)
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCoveoUADimensionResource_Save(t *testing.T) {
+	var body coveoUADimension
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/ua/v15/dimensions/custom" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("org") != "myorg" || q.Get("name") != "c_team" || fmt.Sprint(q["event"]) != "[CLICK SEARCH]" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+	})
+	r := &CoveoUADimensionResource{client: client}
+
+	m := coveoUADimensionModel{
+		APIName:     types.StringValue("c_team"),
+		DisplayName: types.StringValue("Team"),
+		DataType:    types.StringValue("TEXT"),
+		EventTypes:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("CLICK"), types.StringValue("SEARCH")}),
+	}
+	if err := r.save(context.Background(), "POST", &m); err != nil {
+		t.Fatal(err)
+	}
+	if body.DisplayName != "Team" || body.Type != "TEXT" {
+		t.Errorf("unexpected body %+v", body)
+	}
+}
+
+func TestCoveoUADimensionResource_Read(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/ua/v15/dimensions/custom/c_team" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"apiName":"c_team","displayName":"Team name","type":"TEXT","eventTypes":["SEARCH"]}`)
+	})
+	r := &CoveoUADimensionResource{client: client}
+
+	m := coveoUADimensionModel{APIName: types.StringValue("c_team")}
+	if err := r.read(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.DisplayName.ValueString() != "Team name" || m.DataType.ValueString() != "TEXT" || len(m.EventTypes.Elements()) != 1 {
+		t.Errorf("unexpected model %+v", m)
+	}
+}
+
+func TestCoveoUADimensionResource_ValidateConfigAPIName(t *testing.T) {
+	ctx := context.Background()
+	r := &CoveoUADimensionResource{}
+	s := testResourceSchema(ctx, r)
+
+	for apiName, wantErr := range map[string]bool{"c_team": false, "team": true} {
+		config := tfsdk.Config{Schema: s, Raw: testObjectValue(ctx, s, map[string]tftypes.Value{
+			"api_name":     tftypes.NewValue(tftypes.String, apiName),
+			"display_name": tftypes.NewValue(tftypes.String, "Team"),
+			"data_type":    tftypes.NewValue(tftypes.String, "TEXT"),
+		})}
+
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+		if got := resp.Diagnostics.HasError(); got != wantErr {
+			t.Errorf("api_name %q: HasError() = %v, want %v: %v", apiName, got, wantErr, resp.Diagnostics)
+		}
+	}
+}
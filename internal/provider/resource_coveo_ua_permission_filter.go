@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoUAPermissionFilterResource{}
+
+// coveoUAPermissionFilter restricts the analytics data visible to groups and
+// users.
+type coveoUAPermissionFilter struct {
+	ID             string   `json:"id,omitempty"`
+	DisplayName    string   `json:"displayName"`
+	Value          string   `json:"value"`
+	AssignedGroups []string `json:"assignedGroups"`
+	AssignedUsers  []string `json:"assignedUsers"`
+}
+
+// CoveoUAPermissionFilterResource manages a usage analytics permission filter.
+type CoveoUAPermissionFilterResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoUAPermissionFilterResource(client *CoveoClient) resource.Resource {
+	return &CoveoUAPermissionFilterResource{client: client}
+}
+
+type coveoUAPermissionFilterModel struct {
+	ID          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Filter      types.String `tfsdk:"filter"`
+	Groups      types.Set    `tfsdk:"groups"`
+	Users       types.Set    `tfsdk:"users"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoUAPermissionFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_ua_permission_filter"
+}
+
+// Schema defines the schema for the usage analytics permission filter resource.
+func (r *CoveoUAPermissionFilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo usage analytics permission filter, which limits the analytics data that groups and users can see.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The filter ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The filter name.",
+			},
+			"filter": schema.StringAttribute{
+				Required:    true,
+				Description: "The filter expression, such as (c_team=='support').",
+			},
+			"groups": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the groups the filter applies to.",
+			},
+			"users": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "The emails of the users the filter applies to.",
+			},
+		},
+	}
+}
+
+func (m *coveoUAPermissionFilterModel) toAPI(ctx context.Context) (coveoUAPermissionFilter, error) {
+	f := coveoUAPermissionFilter{
+		DisplayName:    m.DisplayName.ValueString(),
+		Value:          m.Filter.ValueString(),
+		AssignedGroups: []string{},
+		AssignedUsers:  []string{},
+	}
+	// Unset groups and users are sent as empty lists, not null.
+	if !m.Groups.IsNull() {
+		if diags := m.Groups.ElementsAs(ctx, &f.AssignedGroups, false); diags.HasError() {
+			return f, fmt.Errorf("invalid groups")
+		}
+	}
+	if !m.Users.IsNull() {
+		if diags := m.Users.ElementsAs(ctx, &f.AssignedUsers, false); diags.HasError() {
+			return f, fmt.Errorf("invalid users")
+		}
+	}
+	return f, nil
+}
+
+// stringSetOrNull returns a set of values. An empty values is null, so an
+// omitted attribute doesn't diff, unless prior is an empty set, so a
+// configured empty set doesn't either.
+func stringSetOrNull(ctx context.Context, prior types.Set, values []string) (types.Set, error) {
+	if len(values) == 0 {
+		if prior.IsNull() || prior.IsUnknown() {
+			return types.SetNull(types.StringType), nil
+		}
+		values = []string{}
+	}
+	s, diags := types.SetValueFrom(ctx, types.StringType, values)
+	if diags.HasError() {
+		return s, fmt.Errorf("invalid set values")
+	}
+	return s, nil
+}
+
+func (r *CoveoUAPermissionFilterResource) read(ctx context.Context, m *coveoUAPermissionFilterModel) error {
+	body, err := r.client.DoUARequest(ctx, "GET", "filters/permissions/"+url.PathEscape(m.ID.ValueString()), nil, nil)
+	if err != nil {
+		return err
+	}
+	var f coveoUAPermissionFilter
+	if err := json.Unmarshal(body, &f); err != nil {
+		return err
+	}
+	m.DisplayName = types.StringValue(f.DisplayName)
+	m.Filter = types.StringValue(f.Value)
+	if m.Groups, err = stringSetOrNull(ctx, m.Groups, f.AssignedGroups); err != nil {
+		return err
+	}
+	if m.Users, err = stringSetOrNull(ctx, m.Users, f.AssignedUsers); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Create creates the permission filter.
+func (r *CoveoUAPermissionFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoUAPermissionFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	body, err := r.client.DoUARequest(ctx, "POST", "filters/permissions", nil, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create usage analytics permission filter: %s", err))
+		return
+	}
+	var created coveoUAPermissionFilter
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid filter ID.")
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the permission filter.
+func (r *CoveoUAPermissionFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoUAPermissionFilterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read usage analytics permission filter: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the permission filter.
+func (r *CoveoUAPermissionFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoUAPermissionFilterModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, err := plan.toAPI(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	filter.ID = plan.ID.ValueString()
+	if _, err := r.client.DoUARequest(ctx, "PUT", "filters/permissions/"+url.PathEscape(filter.ID), nil, filter); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update usage analytics permission filter: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the permission filter.
+func (r *CoveoUAPermissionFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoUAPermissionFilterModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoUARequest(ctx, "DELETE", "filters/permissions/"+url.PathEscape(state.ID.ValueString()), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete usage analytics permission filter: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a permission filter by ID.
+func (r *CoveoUAPermissionFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCoveoUAPermissionFilterModel_ToAPI(t *testing.T) {
+	m := coveoUAPermissionFilterModel{
+		DisplayName: types.StringValue("Support"),
+		Filter:      types.StringValue("(c_team=='support')"),
+		Groups:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("support")}),
+		Users:       types.SetNull(types.StringType),
+	}
+	filter, err := m.toAPI(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := json.Marshal(filter)
+	want := `{"displayName":"Support","value":"(c_team=='support')","assignedGroups":["support"],"assignedUsers":[]}`
+	if string(body) != want {
+		t.Errorf("request body = %s, want %s", body, want)
+	}
+}
+
+func TestCoveoUAPermissionFilterResource_Read(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/ua/v15/filters/permissions/f1" || r.URL.Query().Get("org") != "myorg" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		fmt.Fprint(w, `{"id":"f1","displayName":"Support","value":"(c_team=='support')","assignedGroups":["support"],"assignedUsers":[]}`)
+	})
+	r := &CoveoUAPermissionFilterResource{client: client}
+
+	m := coveoUAPermissionFilterModel{ID: types.StringValue("f1"), Groups: types.SetNull(types.StringType), Users: types.SetNull(types.StringType)}
+	if err := r.read(context.Background(), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Filter.ValueString() != "(c_team=='support')" || len(m.Groups.Elements()) != 1 || !m.Users.IsNull() {
+		t.Errorf("unexpected model %+v", m)
+	}
+}
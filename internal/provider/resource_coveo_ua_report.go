@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithImportState = &CoveoUAReportResource{}
+
+// coveoUAReport is a usage analytics dashboard or explorer report.
+type coveoUAReport struct {
+	ID            string          `json:"id,omitempty"`
+	Name          string          `json:"name"`
+	Type          string          `json:"type"`
+	Configuration json.RawMessage `json:"configuration"`
+}
+
+// CoveoUAReportResource manages a usage analytics report.
+type CoveoUAReportResource struct {
+	client *CoveoClient
+}
+
+func NewCoveoUAReportResource(client *CoveoClient) resource.Resource {
+	return &CoveoUAReportResource{client: client}
+}
+
+type coveoUAReportModel struct {
+	ID            types.String         `tfsdk:"id"`
+	Name          types.String         `tfsdk:"name"`
+	Type          types.String         `tfsdk:"type"`
+	Configuration jsontypes.Normalized `tfsdk:"configuration"`
+}
+
+// Metadata sets the resource type name.
+func (r *CoveoUAReportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "coveo_ua_report"
+}
+
+// Schema defines the schema for the usage analytics report resource.
+func (r *CoveoUAReportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Coveo usage analytics dashboard or explorer report.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The report ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The report name.",
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The report type: DASHBOARD or EXPLORER.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configuration": schema.StringAttribute{
+				Required:    true,
+				CustomType:  jsontypes.NormalizedType{},
+				Description: "The report configuration, as a JSON document. Differences in key order or formatting with the stored configuration are ignored.",
+			},
+		},
+	}
+}
+
+func (m *coveoUAReportModel) toAPI() coveoUAReport {
+	return coveoUAReport{
+		Name:          m.Name.ValueString(),
+		Type:          m.Type.ValueString(),
+		Configuration: json.RawMessage(m.Configuration.ValueString()),
+	}
+}
+
+func (r *CoveoUAReportResource) read(ctx context.Context, m *coveoUAReportModel) error {
+	body, err := r.client.DoUARequest(ctx, "GET", "reports/"+url.PathEscape(m.ID.ValueString()), nil, nil)
+	if err != nil {
+		return err
+	}
+	var report coveoUAReport
+	if err := json.Unmarshal(body, &report); err != nil {
+		return err
+	}
+	m.Name = types.StringValue(report.Name)
+	m.Type = types.StringValue(report.Type)
+	m.Configuration = jsontypes.NewNormalizedValue(string(report.Configuration))
+	return nil
+}
+
+// Create creates the report.
+func (r *CoveoUAReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Client Error", "The Coveo client was not properly initialized.")
+		return
+	}
+
+	var plan coveoUAReportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := r.client.DoUARequest(ctx, "POST", "reports", nil, plan.toAPI())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to create usage analytics report: %s", err))
+		return
+	}
+	var created coveoUAReport
+	if err := json.Unmarshal(body, &created); err != nil || created.ID == "" {
+		resp.Diagnostics.AddError("Invalid Response", "Coveo API response did not include a valid report ID.")
+		return
+	}
+
+	plan.ID = types.StringValue(created.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the report.
+func (r *CoveoUAReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state coveoUAReportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.read(ctx, &state); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to read usage analytics report: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update replaces the report.
+func (r *CoveoUAReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan coveoUAReportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report := plan.toAPI()
+	report.ID = plan.ID.ValueString()
+	if _, err := r.client.DoUARequest(ctx, "PUT", "reports/"+url.PathEscape(report.ID), nil, report); err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to update usage analytics report: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the report.
+func (r *CoveoUAReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state coveoUAReportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DoUARequest(ctx, "DELETE", "reports/"+url.PathEscape(state.ID.ValueString()), nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Failed to delete usage analytics report: %s", err))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a report by ID.
+func (r *CoveoUAReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCoveoUAReportModel_ToAPI(t *testing.T) {
+	m := coveoUAReportModel{
+		Name:          types.StringValue("Support"),
+		Type:          types.StringValue("DASHBOARD"),
+		Configuration: jsontypes.NewNormalizedValue(`{"period": "P1M"}`),
+	}
+	body, err := json.Marshal(m.toAPI())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":"Support","type":"DASHBOARD","configuration":{"period":"P1M"}}`
+	if string(body) != want {
+		t.Errorf("request body = %s, want %s", body, want)
+	}
+}
+
+func TestCoveoUAReportResource_ReadSemanticallyEqualConfiguration(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/ua/v15/reports/r1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"id":"r1","name":"Support","type":"DASHBOARD","configuration":{"b":[1,2],"a":"x"}}`)
+	})
+	r := &CoveoUAReportResource{client: client}
+
+	ctx := context.Background()
+	prior := jsontypes.NewNormalizedValue("{\n  \"a\": \"x\",\n  \"b\": [1, 2]\n}")
+	m := coveoUAReportModel{ID: types.StringValue("r1"), Configuration: prior}
+	if err := r.read(ctx, &m); err != nil {
+		t.Fatal(err)
+	}
+	equal, diags := prior.StringSemanticEquals(ctx, m.Configuration)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !equal {
+		t.Errorf("configuration %s is not semantically equal to %s", m.Configuration, prior)
+	}
+
+	changed := jsontypes.NewNormalizedValue(`{"a":"y","b":[1,2]}`)
+	if equal, _ := changed.StringSemanticEquals(ctx, m.Configuration); equal {
+		t.Errorf("configuration %s is semantically equal to %s", m.Configuration, changed)
+	}
+}